@@ -0,0 +1,50 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+sl-server serves the operators of the subjectivelogic package as a JSON web service, see package server for the endpoints.
+
+Usage:
+
+	sl-server [-addr host:port] [-prefix /path]
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/server"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	prefix := flag.String("prefix", "", "path prefix to serve the endpoints under, e.g. /api")
+	flag.Parse()
+
+	mux := http.NewServeMux()
+	p := strings.TrimSuffix(*prefix, "/")
+	mux.Handle(p+"/", http.StripPrefix(p, server.NewHandler()))
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("sl-server listening on %s", *addr)
+	log.Fatal(srv.ListenAndServe())
+}
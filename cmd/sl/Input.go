@@ -0,0 +1,159 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+detectFormat returns the input format of data: json if it starts with an object or array, csv otherwise.
+*/
+func detectFormat(data []byte, format string) string {
+	if format != "" {
+		return format
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+		return "json"
+	}
+	return "csv"
+}
+
+/*
+readOpinions reads a list of opinions from r.
+JSON input is either a single opinion object or an array of them, CSV input has one opinion b,d,u,a per record.
+*/
+func readOpinions(r io.Reader, format string) ([]sl.Opinion, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	switch detectFormat(data, format) {
+	case "json":
+		trimmed := bytes.TrimSpace(data)
+		if len(trimmed) > 0 && trimmed[0] == '{' {
+			var o sl.Opinion
+			if err := json.Unmarshal(trimmed, &o); err != nil {
+				return nil, fmt.Errorf("invalid JSON input: %w", err)
+			}
+			return []sl.Opinion{o}, nil
+		}
+		var opinions []sl.Opinion
+		if err := json.Unmarshal(trimmed, &opinions); err != nil {
+			return nil, fmt.Errorf("invalid JSON input: %w", err)
+		}
+		return opinions, nil
+	case "csv":
+		records, err := readCSV(data, 4)
+		if err != nil {
+			return nil, err
+		}
+		opinions := make([]sl.Opinion, len(records))
+		for i, record := range records {
+			if opinions[i], err = parseOpinion(record); err != nil {
+				return nil, fmt.Errorf("invalid CSV record %d: %w", i+1, err)
+			}
+		}
+		return opinions, nil
+	}
+	return nil, fmt.Errorf("unknown input format %q", format)
+}
+
+/*
+readBindings reads named opinions from r.
+JSON input is an object mapping names to opinion objects, CSV input has one record name,b,d,u,a per opinion.
+*/
+func readBindings(r io.Reader, format string) (expression.Bindings, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	switch detectFormat(data, format) {
+	case "json":
+		bindings := expression.Bindings{}
+		if len(bytes.TrimSpace(data)) == 0 {
+			return bindings, nil
+		}
+		if err := json.Unmarshal(data, &bindings); err != nil {
+			return nil, fmt.Errorf("invalid JSON input: %w", err)
+		}
+		return bindings, nil
+	case "csv":
+		records, err := readCSV(data, 5)
+		if err != nil {
+			return nil, err
+		}
+		bindings := expression.Bindings{}
+		for i, record := range records {
+			o, err := parseOpinion(record[1:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid CSV record %d: %w", i+1, err)
+			}
+			bindings[strings.TrimSpace(record[0])] = o
+		}
+		return bindings, nil
+	}
+	return nil, fmt.Errorf("unknown input format %q", format)
+}
+
+/*
+readCSV returns all records of data that consist of the given number of fields, skipping a header record if present.
+*/
+func readCSV(data []byte, fields int) ([][]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = fields
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV input: %w", err)
+	}
+	if len(records) > 0 {
+		if _, err := strconv.ParseFloat(records[0][fields-1], 64); err != nil {
+			records = records[1:]
+		}
+	}
+	return records, nil
+}
+
+func parseOpinion(fields []string) (sl.Opinion, error) {
+	values, err := parseFloats(fields)
+	if err != nil {
+		return sl.Opinion{}, err
+	}
+	return sl.NewOpinion(values[0], values[1], values[2], values[3])
+}
+
+func parseFloats(fields []string) ([]float64, error) {
+	values := make([]float64, len(fields))
+	for i, field := range fields {
+		v, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
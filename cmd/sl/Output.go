@@ -0,0 +1,79 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+result is the JSON representation of an opinion together with the values derived from it.
+Infinite evidence and Beta parameters of dogmatic opinions are represented as null.
+*/
+type result struct {
+	Opinion              *sl.Opinion `json:"opinion"`
+	ProjectedProbability float64     `json:"projected_probability"`
+	Evidence             struct {
+		R *float64 `json:"r"`
+		S *float64 `json:"s"`
+	} `json:"evidence"`
+	Beta struct {
+		Alpha *float64 `json:"alpha"`
+		Beta  *float64 `json:"beta"`
+	} `json:"beta"`
+}
+
+func finite(f float64) *float64 {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil
+	}
+	return &f
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', 12, 64)
+}
+
+/*
+writeOpinion prints o together with its projected probability, evidence and Beta parameters to w,
+either as aligned text or as a JSON object.
+*/
+func writeOpinion(w io.Writer, o sl.Opinion, format string) error {
+	r, s := o.Evidence()
+	alpha, beta := o.BetaParameters()
+
+	switch format {
+	case "json":
+		res := result{Opinion: &o, ProjectedProbability: o.ProjectedProbability()}
+		res.Evidence.R, res.Evidence.S = finite(r), finite(s)
+		res.Beta.Alpha, res.Beta.Beta = finite(alpha), finite(beta)
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(res)
+	case "text":
+		_, err := fmt.Fprintf(w, "belief       %s\ndisbelief    %s\nuncertainty  %s\nbase rate    %s\n"+
+			"projected    %s\nevidence     r = %s, s = %s\nbeta         α = %s, β = %s\n",
+			formatFloat(o.Belief()), formatFloat(o.Disbelief()), formatFloat(o.Uncertainty()), formatFloat(o.BaseRate()),
+			formatFloat(o.ProjectedProbability()), formatFloat(r), formatFloat(s), formatFloat(alpha), formatFloat(beta))
+		return err
+	}
+	return fmt.Errorf("unknown output format %q", format)
+}
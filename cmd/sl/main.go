@@ -0,0 +1,179 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+sl is a command-line tool to construct Subjective Logic opinions, apply the operators of the subjectivelogic package to them
+and print the results together with their projected probability and Beta parameters.
+
+Usage:
+
+	sl opinion [flags] b d u a     construct an opinion from its values
+	sl evidence [flags] r s a      construct an opinion from positive and negative evidence
+	sl apply [flags] operator      apply an operator to the opinions read from stdin
+	sl eval [flags] formula        evaluate a formula against the named opinions read from stdin
+	sl operators                   list the available operators
+	sl repl [file]                 start an interactive session, optionally loading the opinions saved in file
+
+Opinions are read from stdin either as JSON (a single opinion object, an array of them or, for eval,
+an object mapping names to them) or as CSV with one record b,d,u,a (name,b,d,u,a for eval) per opinion.
+*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	"github.com/vs-uulm/go-subjectivelogic/pkg/repl"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+const usage = `usage:
+  sl opinion [flags] b d u a     construct an opinion from its values
+  sl evidence [flags] r s a      construct an opinion from positive and negative evidence
+  sl apply [flags] operator      apply an operator to the opinions read from stdin
+  sl eval [flags] formula        evaluate a formula against the named opinions read from stdin
+  sl operators                   list the available operators
+  sl repl [file]                 start an interactive session, optionally loading the opinions saved in file
+
+flags:
+  -i format    input format: json or csv (detected from the input by default)
+  -o format    output format: text or json (default text)
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+/*
+run executes the command given by args and returns the exit code of the tool.
+*/
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "operators":
+		fmt.Fprintln(stdout, strings.Join(sl.DefaultRegistry.Names(), "\n"))
+		return 0
+	case "repl":
+		return runRepl(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	}
+
+	flags := flag.NewFlagSet("sl "+args[0], flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { fmt.Fprint(stderr, usage) }
+	input := flags.String("i", "", "input format: json or csv")
+	output := flags.String("o", "text", "output format: text or json")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	o, err := execute(args[0], flags.Args(), stdin, *input)
+	if errors.Is(err, errUsage) {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	if err == nil {
+		err = writeOpinion(stdout, o, *output)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, "sl:", err)
+		return 1
+	}
+	return 0
+}
+
+var errUsage = errors.New("usage")
+
+/*
+language evaluates formulas with the operators of subjectivelogic.DefaultRegistry.
+*/
+var language = expression.Language{Registry: sl.DefaultRegistry}
+
+/*
+runRepl starts an interactive session on stdin and stdout, loading the opinions saved in the file named by args, if any.
+*/
+func runRepl(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 1 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+	session := repl.NewSession()
+	if len(args) == 1 {
+		if err := session.LoadFile(args[0]); err != nil {
+			fmt.Fprintln(stderr, "sl:", err)
+			return 1
+		}
+	}
+	if err := session.Run(stdin, stdout); err != nil {
+		fmt.Fprintln(stderr, "sl:", err)
+		return 1
+	}
+	return 0
+}
+
+/*
+execute runs a single command producing an opinion and returns the resulting opinion.
+*/
+func execute(command string, args []string, stdin io.Reader, input string) (sl.Opinion, error) {
+	switch command {
+	case "opinion":
+		if len(args) != 4 {
+			return sl.Opinion{}, errUsage
+		}
+		values, err := parseFloats(args)
+		if err != nil {
+			return sl.Opinion{}, err
+		}
+		return sl.NewOpinion(values[0], values[1], values[2], values[3])
+	case "evidence":
+		if len(args) != 3 {
+			return sl.Opinion{}, errUsage
+		}
+		values, err := parseFloats(args)
+		if err != nil {
+			return sl.Opinion{}, err
+		}
+		return sl.NewOpinionFromEvidence(values[0], values[1], values[2])
+	case "apply":
+		if len(args) != 1 {
+			return sl.Opinion{}, errUsage
+		}
+		opinions, err := readOpinions(stdin, input)
+		if err != nil {
+			return sl.Opinion{}, err
+		}
+		return sl.DefaultRegistry.Apply(args[0], opinions...)
+	case "eval":
+		if len(args) != 1 {
+			return sl.Opinion{}, errUsage
+		}
+		bindings, err := readBindings(stdin, input)
+		if err != nil {
+			return sl.Opinion{}, err
+		}
+		return language.EvaluateString(args[0], bindings)
+	}
+	return sl.Opinion{}, errUsage
+}
@@ -0,0 +1,77 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		wantCode int
+		wantOut  []string
+	}{
+		//usage errors
+		{"TestRun1", nil, "", 2, nil},
+		{"TestRun2", []string{"unknown"}, "", 2, nil},
+		{"TestRun3", []string{"opinion", "1", "0"}, "", 2, nil},
+		{"TestRun4", []string{"evidence", "1", "0", "0.5", "0.5"}, "", 2, nil},
+
+		//invalid input
+		{"TestRun5", []string{"opinion", "1", "1", "1", "1"}, "", 1, nil},
+		{"TestRun6", []string{"opinion", "1", "x", "0", "0.5"}, "", 1, nil},
+		{"TestRun7", []string{"apply", "fuse_cum"}, "1,0,0,0.5\n", 1, nil},
+		{"TestRun8", []string{"apply", "unknown"}, "1,0,0,0.5\n1,0,0,0.5\n", 1, nil},
+		{"TestRun9", []string{"eval", "x ⊕ z"}, `{"x": {"belief": 1, "disbelief": 0, "uncertainty": 0, "base_rate": 0.5}}`, 1, nil},
+		{"TestRun10", []string{"apply", "not"}, `{"belief": 1, "disbelief": 1, "uncertainty": 0, "base_rate": 0.5}`, 1, nil},
+
+		//general tests
+		{"TestRun11", []string{"opinion", "0.6", "0.2", "0.2", "0.2"}, "", 0,
+			[]string{"belief       0.6\n", "projected    0.64\n", "evidence     r = 6, s = 2\n", "beta         α = 6.4, β = 3.6\n"}},
+		{"TestRun12", []string{"evidence", "-o", "json", "6", "2", "0.2"}, "", 0,
+			[]string{`"belief": 0.6`, `"projected_probability": 0.64`, `"beta": 3.6`}},
+		{"TestRun13", []string{"opinion", "-o", "json", "1", "0", "0", "0.5"}, "", 0,
+			[]string{`"r": null`, `"alpha": null`, `"beta": 1`}},
+		{"TestRun14", []string{"apply", "disc"}, "b,d,u,a\n0.6,0.3,0.1,0\n0.091,0.604,0.305,0.4\n", 0,
+			[]string{"belief       0.0546\n", "disbelief    0.3624\n", "uncertainty  0.583\n"}},
+		{"TestRun15", []string{"apply", "-i", "json", "not"}, `[{"belief": 0.6, "disbelief": 0.3, "uncertainty": 0.1, "base_rate": 0}]`, 0,
+			[]string{"belief       0.3\n", "base rate    1\n"}},
+		{"TestRun16", []string{"eval", "trust(A,B) ⊗ op(B,x)"}, "\"trust(A,B)\", 0.6, 0.3, 0.1, 0\n\"op(B,x)\", 0.091, 0.604, 0.305, 0.4\n", 0,
+			[]string{"belief       0.0546\n"}},
+		{"TestRun17", []string{"operators"}, "", 0, []string{"fuse_cum\n", "disc_multi\n"}},
+		{"TestRun18", []string{"repl"}, "x = (1, 0, 0, 0.5)\n¬x\n", 0, []string{"(0, 1, 0, 0.5)  P = 0\n"}},
+		{"TestRun19", []string{"repl", "missing.json"}, "", 1, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.wantCode {
+				t.Errorf("run() code = %d, want %d | stderr: %s", code, tt.wantCode, stderr.String())
+				return
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("run() output = %q, want it to contain %q", stdout.String(), want)
+				}
+			}
+		})
+	}
+}
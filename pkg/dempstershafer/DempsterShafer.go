@@ -0,0 +1,193 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package dempstershafer converts between basic belief assignments of the Dempster-Shafer theory and opinions of subjective logic,
+and combines assignments with Dempster's rule, whose subjective logic counterpart is the belief constraint fusion.
+*/
+package dempstershafer
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+MaxSize is the maximum number of elements of a frame of discernment.
+*/
+const MaxSize = 64
+
+/*
+Set is a subset of a frame of discernment, whose elements are numbered from 0. Element i belongs to the Set if bit i is set.
+*/
+type Set uint64
+
+/*
+Singleton returns the Set holding only the element i. It panics if i does not lie in [0, MaxSize).
+*/
+func Singleton(i int) Set {
+	if i < 0 || i >= MaxSize {
+		panic("Singleton(): element " + strconv.Itoa(i) + " out of range")
+	}
+	return 1 << i
+}
+
+/*
+Frame returns the Set of all elements of a frame of discernment with the given size.
+*/
+func Frame(size int) Set {
+	if size >= MaxSize {
+		return math.MaxUint64
+	}
+	return 1<<size - 1
+}
+
+/*
+Contains is called onto a Set s and returns whether the element i belongs to s. Elements outside of [0, MaxSize) never belong to a Set.
+*/
+func (s Set) Contains(i int) bool {
+	return i >= 0 && i < MaxSize && s&Singleton(i) != 0
+}
+
+/*
+Len is called onto a Set s and returns the number of its elements.
+*/
+func (s Set) Len() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+/*
+String is called onto a Set s and returns its elements, e.g. {0, 2}.
+*/
+func (s Set) String() string {
+	elements := []string{}
+	for i := 0; i < MaxSize; i++ {
+		if s.Contains(i) {
+			elements = append(elements, strconv.Itoa(i))
+		}
+	}
+	return "{" + strings.Join(elements, ", ") + "}"
+}
+
+/*
+Assignment is a basic belief assignment on a frame of discernment with Size elements, which assigns the mass of every focal set.
+Sets without mass are omitted.
+*/
+type Assignment struct {
+	Size   int
+	Masses map[Set]float64
+}
+
+/*
+NewAssignment takes the size of a frame of discernment and the masses of subsets of it, and outputs the corresponding Assignment as well as an Error.
+The masses must be non-negative, must be assigned to non-empty subsets of the frame and must sum up to 1 with the tolerance of the subjectivelogic package.
+Sets with a mass of 0 are removed.
+*/
+func NewAssignment(size int, masses map[Set]float64) (Assignment, error) {
+	if size < 1 || size > MaxSize {
+		return Assignment{}, fmt.Errorf("NewAssignment: size must lie in [1, %d]", MaxSize)
+	}
+	m := Assignment{Size: size, Masses: make(map[Set]float64, len(masses))}
+	sum := 0.0
+	for s, mass := range masses {
+		if s == 0 || s&^Frame(size) != 0 {
+			return Assignment{}, fmt.Errorf("NewAssignment: %v is not a non-empty subset of the frame", s)
+		}
+		if !(mass >= 0) || math.IsInf(mass, 1) {
+			return Assignment{}, fmt.Errorf("NewAssignment: mass of %v must be non-negative", s)
+		}
+		if mass > 0 {
+			m.Masses[s] = mass
+			sum += mass
+		}
+	}
+	if math.Abs(1-sum) >= 3*sl.Precision {
+		return Assignment{}, fmt.Errorf("NewAssignment: masses sum up to %v instead of 1", sum)
+	}
+	return m, nil
+}
+
+/*
+Belief is called onto an Assignment m and returns the belief of the Set s, i.e. the sum of the masses of all subsets of s.
+*/
+func (m Assignment) Belief(s Set) float64 {
+	belief := 0.0
+	for focal, mass := range m.Masses {
+		if focal&^s == 0 {
+			belief += mass
+		}
+	}
+	return belief
+}
+
+/*
+Plausibility is called onto an Assignment m and returns the plausibility of the Set s, i.e. the sum of the masses of all sets intersecting s.
+*/
+func (m Assignment) Plausibility(s Set) float64 {
+	plausibility := 0.0
+	for focal, mass := range m.Masses {
+		if focal&s != 0 {
+			plausibility += mass
+		}
+	}
+	return plausibility
+}
+
+/*
+FocalSets is called onto an Assignment m and returns the sets with mass in ascending order.
+*/
+func (m Assignment) FocalSets() []Set {
+	sets := make([]Set, 0, len(m.Masses))
+	for s := range m.Masses {
+		sets = append(sets, s)
+	}
+	sort.Slice(sets, func(i, j int) bool { return sets[i] < sets[j] })
+	return sets
+}
+
+/*
+Dempster combines the Assignments m1 and m2 on the same frame of discernment with Dempster's rule and returns the combined Assignment
+as well as the degree of conflict K, i.e. the mass the rule assigns to the empty set before normalising by 1-K.
+For binomial frames, belief, disbelief and uncertainty of the result equal those of subjectivelogic.ConstraintFusion.
+If the assignments are totally conflicting, i.e. K = 1, an error wrapping subjectivelogic.ErrTotalConflict is returned.
+*/
+func Dempster(m1, m2 Assignment) (Assignment, float64, error) {
+	if m1.Size != m2.Size {
+		return Assignment{}, 0, fmt.Errorf("Dempster: frames of size %d and %d differ", m1.Size, m2.Size)
+	}
+	combined := map[Set]float64{}
+	conflict := 0.0
+	for s1, mass1 := range m1.Masses {
+		for s2, mass2 := range m2.Masses {
+			if s := s1 & s2; s != 0 {
+				combined[s] += mass1 * mass2
+			} else {
+				conflict += mass1 * mass2
+			}
+		}
+	}
+	if len(combined) == 0 || conflict >= 1 {
+		return Assignment{}, conflict, fmt.Errorf("Dempster: %w", sl.ErrTotalConflict)
+	}
+	for s := range combined {
+		combined[s] /= 1 - conflict
+	}
+	return Assignment{Size: m1.Size, Masses: combined}, conflict, nil
+}
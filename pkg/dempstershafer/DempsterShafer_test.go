@@ -0,0 +1,179 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package dempstershafer
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func closeTo(x, y float64) bool {
+	return math.Abs(x-y) < 1e-9
+}
+
+func TestSet(t *testing.T) {
+	s := Singleton(0) | Singleton(MaxSize-1)
+	tests := []struct {
+		name string
+		i    int
+		want bool
+	}{
+		{"TestSet1", 0, true},
+		{"TestSet2", 1, false},
+		{"TestSet3", MaxSize - 1, true},
+		{"TestSet4", MaxSize, false},
+		{"TestSet5", -1, false},
+		{"TestSet6", MaxSize + 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Contains(tt.i); got != tt.want {
+				t.Errorf("Contains(%d) = %v, want %v", tt.i, got, tt.want)
+			}
+		})
+	}
+	if got := s.String(); got != "{0, 63}" {
+		t.Errorf("String() = %s, want {0, 63}", got)
+	}
+
+	for _, i := range []int{-1, MaxSize} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Singleton(%d) did not panic", i)
+				}
+			}()
+			Singleton(i)
+		}()
+	}
+}
+
+func TestNewAssignment(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		masses  map[Set]float64
+		wantErr bool
+	}{
+		{"TestNewAssignment1", 3, map[Set]float64{Singleton(0): 0.5, Frame(3): 0.5}, false},
+		{"TestNewAssignment2", 3, map[Set]float64{Singleton(0) | Singleton(2): 1, Singleton(1): 0}, false},
+		{"TestNewAssignment3", 0, map[Set]float64{}, true},
+		{"TestNewAssignment4", 65, map[Set]float64{Frame(64): 1}, true},
+		{"TestNewAssignment5", 2, map[Set]float64{0: 0.5, Frame(2): 0.5}, true},
+		{"TestNewAssignment6", 2, map[Set]float64{Singleton(2): 0.5, Frame(2): 0.5}, true},
+		{"TestNewAssignment7", 2, map[Set]float64{Singleton(0): -0.5, Frame(2): 1.5}, true},
+		{"TestNewAssignment8", 2, map[Set]float64{Singleton(0): 0.5, Frame(2): 0.6}, true},
+		{"TestNewAssignment9", 2, map[Set]float64{Singleton(0): math.NaN(), Frame(2): 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewAssignment(tt.size, tt.masses)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAssignment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for s, mass := range m.Masses {
+				if mass == 0 {
+					t.Errorf("NewAssignment() kept %v without mass", s)
+				}
+			}
+		})
+	}
+}
+
+func TestBeliefPlausibility(t *testing.T) {
+	a, b, c := Singleton(0), Singleton(1), Singleton(2)
+	m, err := NewAssignment(3, map[Set]float64{a: 0.2, a | b: 0.3, c: 0.1, Frame(3): 0.4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		set          Set
+		belief       float64
+		plausibility float64
+	}{
+		{a, 0.2, 0.9},
+		{a | b, 0.5, 0.9},
+		{b | c, 0.1, 0.8},
+		{Frame(3), 1, 1},
+	}
+	for _, tt := range tests {
+		if got := m.Belief(tt.set); !closeTo(got, tt.belief) {
+			t.Errorf("Belief(%v) got = %v, want %v", tt.set, got, tt.belief)
+		}
+		if got := m.Plausibility(tt.set); !closeTo(got, tt.plausibility) {
+			t.Errorf("Plausibility(%v) got = %v, want %v", tt.set, got, tt.plausibility)
+		}
+	}
+	if got := m.FocalSets(); len(got) != 4 || got[0] != a || got[3] != Frame(3) {
+		t.Errorf("FocalSets() got = %v", got)
+	}
+	if got := (a | c).String(); got != "{0, 2}" {
+		t.Errorf("String() got = %q, want {0, 2}", got)
+	}
+}
+
+func TestDempster(t *testing.T) {
+	// Zadeh's example: two experts almost exclude each other's diagnosis and only share a tiny mass for the third one
+	a, b, c := Singleton(0), Singleton(1), Singleton(2)
+	m1, _ := NewAssignment(3, map[Set]float64{a: 0.99, b: 0.01})
+	m2, _ := NewAssignment(3, map[Set]float64{c: 0.99, b: 0.01})
+	got, conflict, err := Dempster(m1, m2)
+	if err != nil || !closeTo(conflict, 0.9999) || len(got.Masses) != 1 || !closeTo(got.Masses[b], 1) {
+		t.Errorf("Dempster() got = %v, %v, %v", got.Masses, conflict, err)
+	}
+
+	m3, _ := NewAssignment(3, map[Set]float64{c: 1})
+	if _, _, err := Dempster(m1, m3); !errors.Is(err, sl.ErrTotalConflict) {
+		t.Errorf("Dempster() error = %v, want %v", err, sl.ErrTotalConflict)
+	}
+	m4, _ := NewAssignment(2, map[Set]float64{Frame(2): 1})
+	if _, _, err := Dempster(m1, m4); err == nil {
+		t.Errorf("Dempster() of different frames does not fail")
+	}
+}
+
+func TestDempsterConstraintFusion(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	random := func() sl.Opinion {
+		b, d, u := r.Float64(), r.Float64(), r.Float64()
+		sum := b + d + u
+		o, err := sl.NewOpinion(b/sum, d/sum, 1-b/sum-d/sum, r.Float64())
+		if err != nil {
+			panic(err)
+		}
+		return o
+	}
+	for i := 0; i < 1000; i++ {
+		x, y := random(), random()
+		want, err := sl.ConstraintFusion(&x, &y)
+		if err != nil {
+			continue
+		}
+		m1, _ := FromBinomial(&x)
+		m2, _ := FromBinomial(&y)
+		m, _, err := Dempster(m1, m2)
+		if err != nil {
+			t.Fatalf("Dempster() error = %v", err)
+		}
+		got, err := m.Binomial(want.BaseRate())
+		if err != nil || !(sl.Evaluator{Tolerance: 1e-9}).Compare(got, want) {
+			t.Fatalf("Dempster() of %v and %v got = %v, %v, want %v", &x, &y, &got, err, &want)
+		}
+	}
+}
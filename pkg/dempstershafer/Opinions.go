@@ -0,0 +1,191 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package dempstershafer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+FromBinomial converts the binomial Opinion o into an Assignment on the binomial frame, whose element 0 is x and element 1 is not x.
+The belief mass becomes the mass of {0}, the disbelief mass that of {1} and the uncertainty mass that of the frame.
+The base rate has no counterpart in the Dempster-Shafer theory and is dropped.
+*/
+func FromBinomial(o *sl.Opinion) (Assignment, error) {
+	if o == nil {
+		return Assignment{}, errors.New("FromBinomial: Input cannot be nil")
+	}
+	return NewAssignment(2, map[Set]float64{Singleton(0): o.Belief(), Singleton(1): o.Disbelief(), Frame(2): o.Uncertainty()})
+}
+
+/*
+Binomial is called onto an Assignment m on a binomial frame and returns the corresponding binomial Opinion with the given base rate,
+reversing FromBinomial. If m is not an Assignment on a frame of two elements, or the base rate violates 0 <= a <= 1,
+a zeroed Opinion and an error are returned.
+*/
+func (m Assignment) Binomial(baseRate float64) (sl.Opinion, error) {
+	if m.Size != 2 {
+		return sl.Opinion{}, fmt.Errorf("Binomial: frame has %d instead of 2 elements", m.Size)
+	}
+	o, err := sl.NewOpinion(m.Masses[Singleton(0)], m.Masses[Singleton(1)], m.Masses[Frame(2)], baseRate)
+	if err != nil {
+		return sl.Opinion{}, fmt.Errorf("Binomial: %w", err)
+	}
+	return o, nil
+}
+
+/*
+HyperOpinion is an opinion about a domain of len(BaseRate) elements, which assigns belief masses to non-empty proper subsets of the domain.
+A multinomial opinion is a HyperOpinion that assigns belief masses only to singletons.
+*/
+type HyperOpinion struct {
+	// Belief holds the belief masses of the sets; sets without belief mass are omitted
+	Belief      map[Set]float64
+	Uncertainty float64
+	// BaseRate holds the base rate of every element of the domain
+	BaseRate []float64
+}
+
+/*
+NewHyperOpinion takes the belief masses of subsets of a domain, the uncertainty mass and the base rates of the elements of the domain,
+and outputs the corresponding HyperOpinion as well as an Error.
+The domain must have at least two elements, belief masses must be assigned to non-empty proper subsets of it,
+the belief and uncertainty masses must be non-negative and sum up to 1, and the base rates must be non-negative and sum up to 1.
+*/
+func NewHyperOpinion(belief map[Set]float64, uncertainty float64, baseRate []float64) (HyperOpinion, error) {
+	if err := checkBaseRates(baseRate); err != nil {
+		return HyperOpinion{}, fmt.Errorf("NewHyperOpinion: %w", err)
+	}
+	size := len(baseRate)
+	masses := make(map[Set]float64, len(belief)+1)
+	for s, b := range belief {
+		if s == Frame(size) {
+			return HyperOpinion{}, errors.New("NewHyperOpinion: belief mass of the whole domain is uncertainty")
+		}
+		masses[s] = b
+	}
+	masses[Frame(size)] = uncertainty
+	m, err := NewAssignment(size, masses)
+	if err != nil {
+		return HyperOpinion{}, fmt.Errorf("NewHyperOpinion: %w", err)
+	}
+	return m.HyperOpinion(baseRate)
+}
+
+/*
+IsMultinomial is called onto a HyperOpinion h and returns whether h assigns belief masses only to singletons.
+*/
+func (h HyperOpinion) IsMultinomial() bool {
+	for s := range h.Belief {
+		if s.Len() != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ProjectedProbability is called onto a HyperOpinion h and returns the projected probability of the element i,
+i.e. the sum of a(i)*u and of the belief masses b(A) of all sets A containing i, weighted with the relative base rate a(i)/a(A).
+Sets with a base rate of 0 share their belief mass evenly among their elements.
+If i is not an element of the domain, i.e. violates 0 <= i < len(h.BaseRate), 0 and an error are returned.
+*/
+func (h HyperOpinion) ProjectedProbability(i int) (float64, error) {
+	if i < 0 || i >= len(h.BaseRate) {
+		return 0, fmt.Errorf("ProjectedProbability: element %d is not in the domain of %d elements", i, len(h.BaseRate))
+	}
+	p := h.BaseRate[i] * h.Uncertainty
+	for s, b := range h.Belief {
+		if !s.Contains(i) {
+			continue
+		}
+		a := 0.0
+		for j := range h.BaseRate {
+			if s.Contains(j) {
+				a += h.BaseRate[j]
+			}
+		}
+		if a == 0 {
+			p += b / float64(s.Len())
+		} else {
+			p += b * h.BaseRate[i] / a
+		}
+	}
+	return p, nil
+}
+
+/*
+FromHyperOpinion converts the HyperOpinion h into an Assignment on its domain. The belief masses become the masses of their sets
+and the uncertainty mass becomes the mass of the whole domain. The base rates have no counterpart in the Dempster-Shafer theory and are dropped.
+*/
+func FromHyperOpinion(h HyperOpinion) (Assignment, error) {
+	masses := make(map[Set]float64, len(h.Belief)+1)
+	for s, b := range h.Belief {
+		masses[s] = b
+	}
+	masses[Frame(len(h.BaseRate))] += h.Uncertainty
+	m, err := NewAssignment(len(h.BaseRate), masses)
+	if err != nil {
+		return Assignment{}, fmt.Errorf("FromHyperOpinion: %w", err)
+	}
+	return m, nil
+}
+
+/*
+HyperOpinion is called onto an Assignment m and returns the corresponding HyperOpinion with the given base rates, reversing FromHyperOpinion.
+The mass of the whole frame becomes the uncertainty mass. If the number of base rates does not match the frame,
+or the base rates are invalid like in NewHyperOpinion, a zeroed HyperOpinion and an error are returned.
+*/
+func (m Assignment) HyperOpinion(baseRate []float64) (HyperOpinion, error) {
+	if len(baseRate) != m.Size {
+		return HyperOpinion{}, fmt.Errorf("HyperOpinion: %d base rates for a frame of %d elements", len(baseRate), m.Size)
+	}
+	if err := checkBaseRates(baseRate); err != nil {
+		return HyperOpinion{}, fmt.Errorf("HyperOpinion: %w", err)
+	}
+	h := HyperOpinion{Belief: make(map[Set]float64, len(m.Masses)), BaseRate: append([]float64(nil), baseRate...)}
+	for s, mass := range m.Masses {
+		if s == Frame(m.Size) {
+			h.Uncertainty = mass
+		} else {
+			h.Belief[s] = mass
+		}
+	}
+	return h, nil
+}
+
+/*
+checkBaseRates returns an error if the base rates do not describe a domain of at least two elements or are not non-negative with a sum of 1.
+*/
+func checkBaseRates(baseRate []float64) error {
+	if len(baseRate) < 2 || len(baseRate) > MaxSize {
+		return fmt.Errorf("domain must have between 2 and %d elements", MaxSize)
+	}
+	sum := 0.0
+	for i, a := range baseRate {
+		if !(a >= 0) {
+			return fmt.Errorf("base rate of element %d must be non-negative", i)
+		}
+		sum += a
+	}
+	if math.Abs(1-sum) >= 3*sl.Precision {
+		return errors.New("base rates must sum up to 1")
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package dempstershafer
+
+import (
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestBinomial(t *testing.T) {
+	o, _ := sl.NewOpinion(0.6, 0.3, 0.1, 0.4)
+	m, err := FromBinomial(&o)
+	if err != nil || m.Size != 2 || m.Masses[Singleton(0)] != 0.6 || m.Masses[Singleton(1)] != 0.3 || m.Masses[Frame(2)] != 0.1 {
+		t.Errorf("FromBinomial() got = %v, %v", m, err)
+	}
+	got, err := m.Binomial(0.4)
+	if err != nil || got != o {
+		t.Errorf("Binomial() got = %v, %v, want %v", &got, err, &o)
+	}
+
+	if _, err := FromBinomial(nil); err == nil {
+		t.Errorf("FromBinomial() of nil does not fail")
+	}
+	if _, err := m.Binomial(1.5); err == nil {
+		t.Errorf("Binomial() with an invalid base rate does not fail")
+	}
+	m3, _ := NewAssignment(3, map[Set]float64{Frame(3): 1})
+	if _, err := m3.Binomial(0.5); err == nil {
+		t.Errorf("Binomial() of a frame of 3 elements does not fail")
+	}
+}
+
+func TestHyperOpinion(t *testing.T) {
+	a, b, c := Singleton(0), Singleton(1), Singleton(2)
+	tests := []struct {
+		name        string
+		belief      map[Set]float64
+		uncertainty float64
+		baseRate    []float64
+		multinomial bool
+		projected   []float64
+		wantErr     bool
+	}{
+		{"TestHyperOpinion1", map[Set]float64{a: 0.5, b: 0.2}, 0.3, []float64{0.2, 0.3, 0.5}, true, []float64{0.56, 0.29, 0.15}, false},
+		{"TestHyperOpinion2", map[Set]float64{a | b: 0.4, c: 0.2}, 0.4, []float64{0.25, 0.25, 0.5}, false, []float64{0.3, 0.3, 0.4}, false},
+		// a set without base rate shares its belief mass evenly
+		{"TestHyperOpinion3", map[Set]float64{a | b: 0.6}, 0.4, []float64{0, 0, 1}, false, []float64{0.3, 0.3, 0.4}, false},
+		{"TestHyperOpinion4", map[Set]float64{a: 0.5}, 0.5, []float64{1}, false, nil, true},
+		{"TestHyperOpinion5", map[Set]float64{a: 0.5}, 0.5, []float64{0.5, 0.6}, false, nil, true},
+		{"TestHyperOpinion6", map[Set]float64{a | b: 0.5}, 0.5, []float64{0.5, 0.5}, false, nil, true},
+		{"TestHyperOpinion7", map[Set]float64{a: 0.5}, 0.6, []float64{0.5, 0.5}, false, nil, true},
+		{"TestHyperOpinion8", map[Set]float64{c: 0.5}, 0.5, []float64{0.5, 0.5}, false, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewHyperOpinion(tt.belief, tt.uncertainty, tt.baseRate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewHyperOpinion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if h.IsMultinomial() != tt.multinomial {
+				t.Errorf("IsMultinomial() got = %v, want %v", h.IsMultinomial(), tt.multinomial)
+			}
+			for i, want := range tt.projected {
+				if got, err := h.ProjectedProbability(i); err != nil || !closeTo(got, want) {
+					t.Errorf("ProjectedProbability(%d) got = %v, %v, want %v", i, got, err, want)
+				}
+			}
+			for _, i := range []int{-1, len(tt.baseRate)} {
+				if got, err := h.ProjectedProbability(i); err == nil {
+					t.Errorf("ProjectedProbability(%d) got = %v, want error", i, got)
+				}
+			}
+
+			m, err := FromHyperOpinion(h)
+			if err != nil || !closeTo(m.Masses[Frame(3)], tt.uncertainty) {
+				t.Fatalf("FromHyperOpinion() got = %v, %v", m, err)
+			}
+			back, err := m.HyperOpinion(tt.baseRate)
+			if err != nil || back.Uncertainty != h.Uncertainty || len(back.Belief) != len(h.Belief) {
+				t.Errorf("HyperOpinion() got = %v, %v, want %v", back, err, h)
+			}
+			for s, b := range h.Belief {
+				if back.Belief[s] != b {
+					t.Errorf("HyperOpinion() got belief %v for %v, want %v", back.Belief[s], s, b)
+				}
+			}
+			if _, err := m.HyperOpinion([]float64{0.5, 0.5}); err == nil {
+				t.Errorf("HyperOpinion() with too few base rates does not fail")
+			}
+		})
+	}
+}
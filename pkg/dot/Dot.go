@@ -0,0 +1,116 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package dot exports trust networks and evaluated formulas in the DOT language of Graphviz,
+annotating nodes and edges with their opinions and projected probabilities.
+*/
+package dot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+	"github.com/vs-uulm/go-subjectivelogic/pkg/trustnet"
+)
+
+/*
+WriteNetwork writes the trust network n to w as a directed graph.
+Agents are drawn as ellipses and propositions as boxes; every edge is labelled with its opinion and projected probability.
+*/
+func WriteNetwork(w io.Writer, n *trustnet.Network) error {
+	if n == nil {
+		return errors.New("WriteNetwork: Input cannot be nil")
+	}
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph trustnetwork {")
+	fmt.Fprintln(out, "\tnode [shape=ellipse];")
+	for _, agent := range n.Agents() {
+		fmt.Fprintf(out, "\t%s [label=%s];\n", id("agent:"+agent), label(agent))
+	}
+	for _, proposition := range n.Propositions() {
+		fmt.Fprintf(out, "\t%s [shape=box, label=%s];\n", id("proposition:"+proposition), label(proposition))
+	}
+	for _, e := range n.TrustEdges() {
+		fmt.Fprintf(out, "\t%s -> %s [label=%s];\n", id("agent:"+e.From), id("agent:"+e.To), label(describe(e.Opinion)))
+	}
+	for _, e := range n.OpinionEdges() {
+		fmt.Fprintf(out, "\t%s -> %s [label=%s, style=dashed];\n", id("agent:"+e.From), id("proposition:"+e.To), label(describe(e.Opinion)))
+	}
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+/*
+WriteEvaluation writes the evaluated formula e to w as a tree whose edges lead from the inputs of an operator to its result.
+Every node is labelled with the operator or opinion it stands for, its opinion and its projected probability.
+*/
+func WriteEvaluation(w io.Writer, e *expression.Evaluation) error {
+	if e == nil {
+		return errors.New("WriteEvaluation: Input cannot be nil")
+	}
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph evaluation {")
+	fmt.Fprintln(out, "\trankdir=BT;")
+	next := 0
+	var walk func(e *expression.Evaluation) string
+	walk = func(e *expression.Evaluation) string {
+		name := fmt.Sprintf("n%d", next)
+		next++
+		shape := "ellipse"
+		title := e.Node.String()
+		switch node := e.Node.(type) {
+		case expression.Literal:
+			title = "literal"
+		case expression.Call:
+			shape = "box"
+			title = node.Func
+			if symbol := expression.Symbol(node.Func); symbol != "" {
+				title = symbol + " " + node.Func
+			}
+		}
+		fmt.Fprintf(out, "\t%s [shape=%s, label=%s];\n", name, shape, label(title+"\n"+describe(e.Value)))
+		for _, child := range e.Children {
+			fmt.Fprintf(out, "\t%s -> %s;\n", walk(child), name)
+		}
+		return name
+	}
+	walk(e)
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+func describe(o sl.Opinion) string {
+	return fmt.Sprintf("(%.4g, %.4g, %.4g, %.4g)\nP = %.4g",
+		o.Belief(), o.Disbelief(), o.Uncertainty(), o.BaseRate(), o.ProjectedProbability())
+}
+
+/*
+id returns s as a quoted DOT identifier.
+*/
+func id(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+/*
+label returns s as a quoted DOT string in which line breaks are kept.
+*/
+func label(s string) string {
+	return strings.ReplaceAll(id(s), "\n", `\n`)
+}
@@ -0,0 +1,104 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package dot
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+	"github.com/vs-uulm/go-subjectivelogic/pkg/trustnet"
+)
+
+func TestWriteNetwork(t *testing.T) {
+	if err := WriteNetwork(io.Discard, nil); err == nil {
+		t.Errorf("Invalid call from \"nil\" passed undetected")
+	}
+
+	trust, err := sl.NewOpinion(0.6, 0.3, 0.1, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opinion, err := sl.NewOpinion(1, 0, 0, 0.25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := trustnet.NewNetwork()
+	_ = n.AddTrust("A", "B", trust)
+	n.AddOpinion("B", `say "x"`, opinion)
+
+	var buf bytes.Buffer
+	if err := WriteNetwork(&buf, n); err != nil {
+		t.Fatal(err)
+	}
+	want := `digraph trustnetwork {
+	node [shape=ellipse];
+	"agent:A" [label="A"];
+	"agent:B" [label="B"];
+	"proposition:say \"x\"" [shape=box, label="say \"x\""];
+	"agent:A" -> "agent:B" [label="(0.6, 0.3, 0.1, 0.5)\nP = 0.65"];
+	"agent:B" -> "proposition:say \"x\"" [label="(1, 0, 0, 0.25)\nP = 1", style=dashed];
+}
+`
+	if buf.String() != want {
+		t.Errorf("WriteNetwork() got = %s, want %s", buf.String(), want)
+	}
+}
+
+func TestWriteEvaluation(t *testing.T) {
+	if err := WriteEvaluation(io.Discard, nil); err == nil {
+		t.Errorf("Invalid call from \"nil\" passed undetected")
+	}
+
+	trust, err := sl.NewOpinion(1, 0, 0, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opinion, err := sl.NewOpinion(0.2, 0.3, 0.5, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := expression.Parse("disc_ob(trust(A,B), op(B,x)) ⊕ (0, 0, 1, 0.5)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := expression.EvaluateTree(n, expression.Bindings{"trust(A,B)": trust, "op(B,x)": opinion})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteEvaluation(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `digraph evaluation {
+	rankdir=BT;
+	n0 [shape=box, label="⊕ fuse_cum\n(0.2, 0.3, 0.5, 0.5)\nP = 0.45"];
+	n1 [shape=box, label="disc_ob\n(0.2, 0.3, 0.5, 0.5)\nP = 0.45"];
+	n2 [shape=ellipse, label="trust(A,B)\n(1, 0, 0, 0.5)\nP = 1"];
+	n2 -> n1;
+	n3 [shape=ellipse, label="op(B,x)\n(0.2, 0.3, 0.5, 0.5)\nP = 0.45"];
+	n3 -> n1;
+	n1 -> n0;
+	n4 [shape=ellipse, label="literal\n(0, 0, 1, 0.5)\nP = 0.5"];
+	n4 -> n0;
+}
+`
+	if buf.String() != want {
+		t.Errorf("WriteEvaluation() got = %s, want %s", buf.String(), want)
+	}
+}
@@ -0,0 +1,67 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package exact
+
+import (
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Audit holds the results of applying an operator of package subjectivelogic and its exact counterpart to the same inputs.
+Divergence is the largest absolute difference between the values of Float and Exact, if both operators succeeded, and 0 otherwise.
+*/
+type Audit struct {
+	Float      sl.Opinion
+	FloatErr   error
+	Exact      Opinion
+	ExactErr   error
+	Divergence float64
+}
+
+/*
+AuditBinary applies the float64 operator floatOp and the exact operator exactOp to the Opinions o1 and o2 and returns an Audit of the results,
+e.g. AuditBinary(subjectivelogic.ConstraintFusion, ConstraintFusion, o1, o2).
+The inputs are converted with FromFloat, an error is returned if that fails.
+*/
+func AuditBinary(floatOp func(*sl.Opinion, *sl.Opinion) (sl.Opinion, error), exactOp func(*Opinion, *Opinion) (Opinion, error),
+	opinion1, opinion2 *sl.Opinion) (Audit, error) {
+	exact1, err := FromFloat(opinion1)
+	if err != nil {
+		return Audit{}, err
+	}
+	exact2, err := FromFloat(opinion2)
+	if err != nil {
+		return Audit{}, err
+	}
+
+	var audit Audit
+	audit.Float, audit.FloatErr = floatOp(opinion1, opinion2)
+	audit.Exact, audit.ExactErr = exactOp(&exact1, &exact2)
+	if audit.FloatErr == nil && audit.ExactErr == nil {
+		audit.Divergence = audit.Exact.Divergence(&audit.Float)
+	}
+	return audit, nil
+}
+
+/*
+Diverges is called onto an Audit a and returns true, if exactly one of the operators failed
+or the results differ by at least tolerance.
+*/
+func (audit Audit) Diverges(tolerance float64) bool {
+	if (audit.FloatErr == nil) != (audit.ExactErr == nil) {
+		return true
+	}
+	return audit.Divergence >= tolerance
+}
@@ -0,0 +1,314 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package exact
+
+import (
+	"math/big"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Addition adds the Opinions o1 and o2 like subjectivelogic.Addition.
+*/
+func Addition(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("Addition", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, d1, u1, a1 := opinion1.belief, opinion1.disbelief, opinion1.uncertainty, opinion1.baseRate
+	b2, d2, u2, a2 := opinion2.belief, opinion2.disbelief, opinion2.uncertainty, opinion2.baseRate
+
+	if a1.Sign() == 0 && a2.Sign() == 0 {
+		return Opinion{}, operatorError("Addition", sl.ErrUndefined, "Base rates cannot be both equal to 0", opinion1, opinion2)
+	}
+
+	b := add(b1, b2)
+	d := quo(add(mul(a1, sub(d1, b2)), mul(a2, sub(d2, b1))), add(a1, a2))
+	u := quo(add(mul(a1, u1), mul(a2, u2)), add(a1, a2))
+	a := add(a1, a2)
+
+	o, err := newResult("Addition", b, d, u, a, opinion1, opinion2)
+	if err != nil {
+		return Opinion{}, operatorError("Addition", sl.ErrInvalidOpinion, "Check the validity of your input values", opinion1, opinion2)
+	}
+	return o, nil
+}
+
+/*
+Complement returns the complement of the Opinion o like subjectivelogic.Complement.
+*/
+func Complement(opinion *Opinion) (Opinion, error) {
+	if opinion == nil {
+		return Opinion{}, operatorError("Complement", sl.ErrNilInput, "", opinion)
+	}
+	return newResult("Complement", opinion.Disbelief(), opinion.Belief(), opinion.Uncertainty(), sub(one, opinion.BaseRate()), opinion)
+}
+
+/*
+Multiplication multiplies the Opinions o1 and o2 like subjectivelogic.Multiplication.
+*/
+func Multiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("Multiplication", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, d1, u1, a1 := opinion1.belief, opinion1.disbelief, opinion1.uncertainty, opinion1.baseRate
+	b2, d2, u2, a2 := opinion2.belief, opinion2.disbelief, opinion2.uncertainty, opinion2.baseRate
+
+	if a1.Cmp(one) == 0 && a2.Cmp(one) == 0 {
+		return Opinion{}, operatorError("Multiplication", sl.ErrUndefined, "Base rates cannot both be 1", opinion1, opinion2)
+	}
+
+	norm := sub(one, mul(a1, a2))
+	b := add(mul(b1, b2), quo(add(mul(mul(sub(one, a1), a2), mul(b1, u2)), mul(mul(a1, sub(one, a2)), mul(u1, b2))), norm))
+	d := sub(add(d1, d2), mul(d1, d2))
+	u := add(mul(u1, u2), quo(add(mul(sub(one, a2), mul(b1, u2)), mul(sub(one, a1), mul(u1, b2))), norm))
+	a := mul(a1, a2)
+
+	return newResult("Multiplication", b, d, u, a, opinion1, opinion2)
+}
+
+/*
+Comultiplication comultiplies the Opinions o1 and o2 like subjectivelogic.Comultiplication.
+*/
+func Comultiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("Comultiplication", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, d1, u1, a1 := opinion1.belief, opinion1.disbelief, opinion1.uncertainty, opinion1.baseRate
+	b2, d2, u2, a2 := opinion2.belief, opinion2.disbelief, opinion2.uncertainty, opinion2.baseRate
+
+	if a1.Sign() == 0 && a2.Sign() == 0 {
+		return Opinion{}, operatorError("Comultiplication", sl.ErrUndefined, "Invalid arguments: opinion1.baseRate = opinion2.baseRate = 0", opinion1, opinion2)
+	}
+
+	a := sub(add(a1, a2), mul(a1, a2))
+	b := sub(add(b1, b2), mul(b1, b2))
+	d := add(mul(d1, d2), quo(add(mul(mul(a1, sub(one, a2)), mul(d1, u2)), mul(mul(sub(one, a1), a2), mul(u1, d2))), a))
+	u := add(mul(u1, u2), quo(add(mul(a2, mul(d1, u2)), mul(a1, mul(u1, d2))), a))
+
+	return newResult("Comultiplication", b, d, u, a, opinion1, opinion2)
+}
+
+/*
+ConstraintFusion fuses the Opinions o1 and o2 like subjectivelogic.ConstraintFusion.
+*/
+func ConstraintFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("ConstraintFusion", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, d1, u1, a1 := opinion1.belief, opinion1.disbelief, opinion1.uncertainty, opinion1.baseRate
+	b2, d2, u2, a2 := opinion2.belief, opinion2.disbelief, opinion2.uncertainty, opinion2.baseRate
+
+	har := add(add(mul(b1, u2), mul(b2, u1)), mul(b1, b2))
+	con := add(mul(b1, d2), mul(b2, d1))
+
+	if con.Cmp(one) == 0 {
+		return Opinion{}, operatorError("ConstraintFusion", sl.ErrTotalConflict, "mathematically possible only if input opinions are not conflicting and do not result in Con = 1", opinion1, opinion2)
+	}
+
+	b := quo(har, sub(one, con))
+	u := quo(mul(u1, u2), sub(one, con))
+	d := sub(sub(one, b), u)
+
+	var a *big.Rat
+	if add(u1, u2).Cmp(two) < 0 {
+		a = quo(add(mul(a1, sub(one, u1)), mul(a2, sub(one, u2))), sub(sub(two, u1), u2))
+	} else {
+		a = mean(a1, a2)
+	}
+
+	return newResult("ConstraintFusion", b, d, u, a, opinion1, opinion2)
+}
+
+/*
+CumulativeFusion fuses the Opinions o1 and o2 like subjectivelogic.CumulativeFusion.
+*/
+func CumulativeFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("CumulativeFusion", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, u1, a1 := opinion1.belief, opinion1.uncertainty, opinion1.baseRate
+	b2, u2, a2 := opinion2.belief, opinion2.uncertainty, opinion2.baseRate
+
+	var b, u, a *big.Rat
+	if u1.Sign() != 0 || u2.Sign() != 0 {
+		norm := sub(add(u1, u2), mul(u1, u2))
+		b = quo(add(mul(b1, u2), mul(b2, u1)), norm)
+		u = quo(mul(u1, u2), norm)
+
+		if u1.Cmp(one) != 0 || u2.Cmp(one) != 0 {
+			u1u2 := mul(u1, u2)
+			a = quo(sub(add(mul(a1, u2), mul(a2, u1)), mul(add(a1, a2), u1u2)), sub(add(u1, u2), mul(two, u1u2)))
+		} else {
+			a = mean(a1, a2)
+		}
+	} else {
+		b = mean(b1, b2)
+		u = new(big.Rat)
+		a = mean(a1, a2)
+	}
+	d := sub(sub(one, b), u)
+
+	return newResult("CumulativeFusion", b, d, u, a, opinion1, opinion2)
+}
+
+/*
+AveragingFusion fuses the Opinions o1 and o2 like subjectivelogic.AveragingFusion.
+*/
+func AveragingFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("AveragingFusion", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, u1, a1 := opinion1.belief, opinion1.uncertainty, opinion1.baseRate
+	b2, u2, a2 := opinion2.belief, opinion2.uncertainty, opinion2.baseRate
+
+	var b, u *big.Rat
+	if u1.Sign() != 0 || u2.Sign() != 0 {
+		b = quo(add(mul(b1, u2), mul(b2, u1)), add(u1, u2))
+		u = quo(mul(two, mul(u1, u2)), add(u1, u2))
+	} else {
+		b = mean(b1, b2)
+		u = new(big.Rat)
+	}
+	d := sub(sub(one, b), u)
+	a := mean(a1, a2)
+
+	return newResult("AveragingFusion", b, d, u, a, opinion1, opinion2)
+}
+
+/*
+WeightedFusion fuses the Opinions o1 and o2 like subjectivelogic.WeightedFusion.
+*/
+func WeightedFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("WeightedFusion", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, u1, a1 := opinion1.belief, opinion1.uncertainty, opinion1.baseRate
+	b2, u2, a2 := opinion2.belief, opinion2.uncertainty, opinion2.baseRate
+
+	var b, u, a *big.Rat
+	if (u1.Sign() != 0 || u2.Sign() != 0) && (u1.Cmp(one) != 0 || u2.Cmp(one) != 0) {
+		c1, c2 := sub(one, u1), sub(one, u2)
+		norm := sub(add(u1, u2), mul(two, mul(u1, u2)))
+		b = quo(add(mul(mul(b1, c1), u2), mul(mul(b2, c2), u1)), norm)
+		u = quo(mul(add(c1, c2), mul(u1, u2)), norm)
+		a = quo(add(mul(a1, c1), mul(a2, c2)), add(c1, c2))
+	} else if u1.Sign() == 0 && u2.Sign() == 0 {
+		b = mean(b1, b2)
+		u = new(big.Rat)
+		a = mean(a1, a2)
+	} else {
+		b = new(big.Rat)
+		u = new(big.Rat).Set(one)
+		a = mean(a1, a2)
+	}
+	d := sub(sub(one, b), u)
+
+	return newResult("WeightedFusion", b, d, u, a, opinion1, opinion2)
+}
+
+/*
+TrustDiscounting discounts the Opinion o2 by the trust Opinion o1 like subjectivelogic.TrustDiscounting.
+*/
+func TrustDiscounting(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("TrustDiscounting", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	p1 := opinion1.ProjectedProbability()
+	b := mul(p1, opinion2.belief)
+	d := mul(p1, opinion2.disbelief)
+	u := sub(sub(one, b), d)
+
+	return newResult("TrustDiscounting", b, d, u, opinion2.BaseRate(), opinion1, opinion2)
+}
+
+/*
+TrustDiscountingOppositeBelief discounts the Opinion o2 by the trust Opinion o1 like subjectivelogic.TrustDiscountingOppositeBelief.
+*/
+func TrustDiscountingOppositeBelief(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("TrustDiscountingOppositeBelief", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, d1, u1 := opinion1.belief, opinion1.disbelief, opinion1.uncertainty
+	b2, d2, u2 := opinion2.belief, opinion2.disbelief, opinion2.uncertainty
+
+	b := add(mul(b1, b2), mul(d1, d2))
+	d := add(mul(b1, d2), mul(d1, b2))
+	u := add(u1, mul(add(b1, d1), u2))
+
+	return newResult("TrustDiscountingOppositeBelief", b, d, u, opinion2.BaseRate(), opinion1, opinion2)
+}
+
+/*
+TrustDiscountingUncertaintyFavouring discounts the Opinion o2 by the trust Opinion o1 like subjectivelogic.TrustDiscountingUncertaintyFavouring.
+*/
+func TrustDiscountingUncertaintyFavouring(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	if err := checkOperands("TrustDiscountingUncertaintyFavouring", opinion1, opinion2); err != nil {
+		return Opinion{}, err
+	}
+	b1, d1, u1 := opinion1.belief, opinion1.disbelief, opinion1.uncertainty
+	b2, d2, u2 := opinion2.belief, opinion2.disbelief, opinion2.uncertainty
+
+	b := mul(b1, b2)
+	d := mul(b1, d2)
+	u := add(add(d1, u1), mul(b1, u2))
+
+	return newResult("TrustDiscountingUncertaintyFavouring", b, d, u, opinion2.BaseRate(), opinion1, opinion2)
+}
+
+/*
+MultiEdgeTrustDisc discounts the last of the Opinions along the trust path formed by the others like subjectivelogic.MultiEdgeTrustDisc.
+*/
+func MultiEdgeTrustDisc(opinions []Opinion) (Opinion, error) {
+	if opinions == nil {
+		return Opinion{}, operatorError("MultiEdgeTrustDisc", sl.ErrNilInput, "")
+	}
+	n := len(opinions)
+	if n < 2 {
+		return Opinion{}, operatorError("MultiEdgeTrustDisc", sl.ErrUndefined, "At least two Opinions required")
+	}
+
+	p := new(big.Rat).Set(one)
+	for _, o := range opinions[:n-1] {
+		p.Mul(p, o.ProjectedProbability())
+	}
+	last := opinions[n-1]
+	b := mul(p, last.Belief())
+	d := mul(p, last.Disbelief())
+	u := sub(sub(one, b), d)
+
+	inputs := make([]*Opinion, n)
+	for i := range opinions {
+		inputs[i] = &opinions[i]
+	}
+	return newResult("MultiEdgeTrustDisc", b, d, u, last.BaseRate(), inputs...)
+}
+
+/*
+checkOperands returns an error for the function op, if one of its inputs is nil or a null opinion.
+*/
+func checkOperands(op string, opinion1, opinion2 *Opinion) error {
+	if opinion1 == nil || opinion2 == nil {
+		return operatorError(op, sl.ErrNilInput, "", opinion1, opinion2)
+	}
+	if opinion1.isNull() || opinion2.isNull() {
+		return operatorError(op, sl.ErrNullOpinion, "", opinion1, opinion2)
+	}
+	return nil
+}
+
+func mean(x, y *big.Rat) *big.Rat {
+	return quo(add(x, y), two)
+}
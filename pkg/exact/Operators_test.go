@@ -0,0 +1,135 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package exact
+
+import (
+	"errors"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+type binaryOperators struct {
+	float func(*sl.Opinion, *sl.Opinion) (sl.Opinion, error)
+	exact func(*Opinion, *Opinion) (Opinion, error)
+}
+
+var operators = map[string]binaryOperators{
+	"Addition":                             {sl.Addition, Addition},
+	"AveragingFusion":                      {sl.AveragingFusion, AveragingFusion},
+	"Comultiplication":                     {sl.Comultiplication, Comultiplication},
+	"ConstraintFusion":                     {sl.ConstraintFusion, ConstraintFusion},
+	"CumulativeFusion":                     {sl.CumulativeFusion, CumulativeFusion},
+	"Multiplication":                       {sl.Multiplication, Multiplication},
+	"TrustDiscounting":                     {sl.TrustDiscounting, TrustDiscounting},
+	"TrustDiscountingOppositeBelief":       {sl.TrustDiscountingOppositeBelief, TrustDiscountingOppositeBelief},
+	"TrustDiscountingUncertaintyFavouring": {sl.TrustDiscountingUncertaintyFavouring, TrustDiscountingUncertaintyFavouring},
+	"WeightedFusion":                       {sl.WeightedFusion, WeightedFusion},
+}
+
+func TestOperators(t *testing.T) {
+	inputs := [][4]float64{
+		{0.6, 0.3, 0.1, 0.5},
+		{0.091, 0.604, 0.305, 0.4},
+		{0.2, 0.2, 0.6, 0.3},
+		{0, 0, 1, 0.5},
+		{0.5, 0.5, 0, 0.5},
+	}
+	for name, op := range operators {
+		for _, v1 := range inputs {
+			for _, v2 := range inputs {
+				o1, _ := sl.NewOpinion(v1[0], v1[1], v1[2], v1[3])
+				o2, _ := sl.NewOpinion(v2[0], v2[1], v2[2], v2[3])
+				audit, err := AuditBinary(op.float, op.exact, &o1, &o2)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if audit.Diverges(1e-12) {
+					t.Errorf("%s(%v, %v) float = %v, %v, exact = %v, %v", name, &o1, &o2, &audit.Float, audit.FloatErr, audit.Exact, audit.ExactErr)
+				}
+				if audit.ExactErr != nil && !errors.Is(audit.ExactErr, errors.Unwrap(audit.FloatErr)) {
+					t.Errorf("%s(%v, %v) error = %v, want %v", name, &o1, &o2, audit.ExactErr, audit.FloatErr)
+				}
+			}
+		}
+	}
+}
+
+func TestOperators_Exact(t *testing.T) {
+	tests := []struct {
+		name string
+		got  func(*Opinion, *Opinion) (Opinion, error)
+		want string
+	}{
+		{"TestOperators_Exact1", CumulativeFusion, "12/19, 6/19, 1/19, 1/2"},
+		{"TestOperators_Exact2", AveragingFusion, "3/5, 3/10, 1/10, 1/2"},
+		{"TestOperators_Exact3", TrustDiscounting, "39/100, 39/200, 83/200, 1/2"},
+		{"TestOperators_Exact4", ConstraintFusion, "3/4, 15/64, 1/64, 1/2"},
+	}
+	o, _ := ParseOpinion("0.6", "0.3", "0.1", "0.5")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.got(&o, &o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	c, _ := Complement(&o)
+	if c.String() != "3/10, 3/5, 1/10, 1/2" {
+		t.Errorf("Complement() got = %v", c)
+	}
+	m, _ := MultiEdgeTrustDisc([]Opinion{o, o, o})
+	if m.String() != "507/2000, 507/4000, 2479/4000, 1/2" {
+		t.Errorf("MultiEdgeTrustDisc() got = %v", m)
+	}
+	if _, err := CumulativeFusion(&o, &Opinion{}); !errors.Is(err, sl.ErrNullOpinion) {
+		t.Errorf("CumulativeFusion() error = %v, want %v", err, sl.ErrNullOpinion)
+	}
+}
+
+func TestAuditBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		op   string
+		o1   [4]float64
+		o2   [4]float64
+		want string
+	}{
+		// the float64 results deviate from b+d+u = 1 by more than Precision
+		{"TestAuditBinary1", "ConstraintFusion", [4]float64{0.1, 0, 0.9, 0.5}, [4]float64{0.5, 0, 0.5, 0.5}, "11/20, 0, 9/20, 1/2"},
+		{"TestAuditBinary2", "Multiplication", [4]float64{0.1, 0, 0.9, 1}, [4]float64{1, 0, 0, 0.4}, "1, 0, 0, 2/5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o1, _ := sl.NewOpinion(tt.o1[0], tt.o1[1], tt.o1[2], tt.o1[3])
+			o2, _ := sl.NewOpinion(tt.o2[0], tt.o2[1], tt.o2[2], tt.o2[3])
+			audit, err := AuditBinary(operators[tt.op].float, operators[tt.op].exact, &o1, &o2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !errors.Is(audit.FloatErr, sl.ErrInvalidOpinion) || audit.ExactErr != nil || !audit.Diverges(1) {
+				t.Errorf("AuditBinary() got = %+v", audit)
+			}
+			if audit.Exact.String() != tt.want {
+				t.Errorf("AuditBinary() exact = %v, want %v", audit.Exact, tt.want)
+			}
+		})
+	}
+}
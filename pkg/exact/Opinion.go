@@ -0,0 +1,245 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package exact mirrors the Opinion type and the operators of package subjectivelogic with arbitrary-precision rational numbers.
+All operators only use the four basic arithmetic operations, so their results are computed without any rounding and can be used
+to audit the float64 implementations.
+*/
+package exact
+
+import (
+	"math/big"
+	"strconv"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+var (
+	zero = big.NewRat(0, 1)
+	one  = big.NewRat(1, 1)
+	two  = big.NewRat(2, 1)
+)
+
+/*
+Opinion represents a Binomial Opinion from Subjective Logic with exact rational values.
+The values of an Opinion are never modified, so Opinions can be copied and shared freely.
+The zero value is the null opinion (0, 0, 0, 0).
+*/
+type Opinion struct {
+	belief      *big.Rat
+	disbelief   *big.Rat
+	uncertainty *big.Rat
+	baseRate    *big.Rat
+}
+
+/*
+NewOpinion takes four *big.Rat values and outputs an Opinion holding copies of them as well as an error.
+Unlike subjectivelogic.NewOpinion, no tolerance is applied: all values i must fulfill 0 <= i <= 1 and b+d+u = 1 must hold exactly.
+Otherwise, a zeroed Opinion and an error wrapping subjectivelogic.ErrInvalidOpinion are returned.
+*/
+func NewOpinion(belief, disbelief, uncertainty, baseRate *big.Rat) (Opinion, error) {
+	if belief == nil || disbelief == nil || uncertainty == nil || baseRate == nil {
+		return Opinion{}, operatorError("NewOpinion", sl.ErrNilInput, "")
+	}
+	return newResult("NewOpinion", new(big.Rat).Set(belief), new(big.Rat).Set(disbelief),
+		new(big.Rat).Set(uncertainty), new(big.Rat).Set(baseRate))
+}
+
+/*
+ParseOpinion takes four strings in a format accepted by big.Rat.SetString, e.g. "0.6" or "3/5", and outputs the corresponding Opinion
+as well as an error.
+*/
+func ParseOpinion(belief, disbelief, uncertainty, baseRate string) (Opinion, error) {
+	var values [4]*big.Rat
+	for i, s := range [4]string{belief, disbelief, uncertainty, baseRate} {
+		v, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return Opinion{}, operatorError("ParseOpinion", sl.ErrInvalidOpinion, "Cannot parse "+strconv.Quote(s))
+		}
+		values[i] = v
+	}
+	return newResult("ParseOpinion", values[0], values[1], values[2], values[3])
+}
+
+/*
+FromFloat takes a *subjectivelogic.Opinion o and outputs the corresponding Opinion as well as an error.
+Each value of o is converted to the shortest decimal number that rounds to it, i.e. the value as it is printed,
+so opinions that were written as decimals, like (0.6, 0.3, 0.1, 0.5), are recovered exactly.
+If the converted values do not sum up to 1 exactly, which subjectivelogic tolerates up to its Precision,
+the uncertainty is set to 1-b-d. If b+d already exceeds 1, b and d are rescaled to b+d = 1 and the uncertainty is set to 0.
+*/
+func FromFloat(opinion *sl.Opinion) (Opinion, error) {
+	if opinion == nil {
+		return Opinion{}, operatorError("FromFloat", sl.ErrNilInput, "")
+	}
+	b, d, a := decimal(opinion.Belief()), decimal(opinion.Disbelief()), decimal(opinion.BaseRate())
+	u := decimal(opinion.Uncertainty())
+	if add(add(b, d), u).Cmp(one) != 0 {
+		if sum := add(b, d); sum.Cmp(one) > 0 {
+			b, d, u = quo(b, sum), quo(d, sum), new(big.Rat)
+		} else {
+			u = sub(one, sum)
+		}
+	}
+	return newResult("FromFloat", b, d, u, a)
+}
+
+/*
+Belief is called onto an Opinion o and returns a copy of o.belief.
+*/
+func (opinion Opinion) Belief() *big.Rat {
+	return value(opinion.belief)
+}
+
+/*
+Disbelief is called onto an Opinion o and returns a copy of o.disbelief.
+*/
+func (opinion Opinion) Disbelief() *big.Rat {
+	return value(opinion.disbelief)
+}
+
+/*
+Uncertainty is called onto an Opinion o and returns a copy of o.uncertainty.
+*/
+func (opinion Opinion) Uncertainty() *big.Rat {
+	return value(opinion.uncertainty)
+}
+
+/*
+BaseRate is called onto an Opinion o and returns a copy of o.baseRate.
+*/
+func (opinion Opinion) BaseRate() *big.Rat {
+	return value(opinion.baseRate)
+}
+
+/*
+ProjectedProbability is called onto an Opinion o and returns the exact projected probability P = b + a*u of o.
+*/
+func (opinion Opinion) ProjectedProbability() *big.Rat {
+	return add(opinion.Belief(), mul(opinion.BaseRate(), opinion.Uncertainty()))
+}
+
+/*
+Equal is called onto an Opinion o1 and returns true, if all values of o1 and o2 are exactly equal.
+*/
+func (opinion1 Opinion) Equal(opinion2 Opinion) bool {
+	return opinion1.Belief().Cmp(opinion2.Belief()) == 0 &&
+		opinion1.Disbelief().Cmp(opinion2.Disbelief()) == 0 &&
+		opinion1.Uncertainty().Cmp(opinion2.Uncertainty()) == 0 &&
+		opinion1.BaseRate().Cmp(opinion2.BaseRate()) == 0
+}
+
+/*
+Float is called onto an Opinion o and returns the subjectivelogic.Opinion holding the float64 values nearest to those of o.
+*/
+func (opinion Opinion) Float() (sl.Opinion, error) {
+	b, _ := opinion.Belief().Float64()
+	d, _ := opinion.Disbelief().Float64()
+	u, _ := opinion.Uncertainty().Float64()
+	a, _ := opinion.BaseRate().Float64()
+	return sl.NewOpinion(b, d, u, a)
+}
+
+/*
+Divergence is called onto an Opinion o and returns the largest absolute difference between a value of o and
+the corresponding value of the *subjectivelogic.Opinion f, computed exactly and rounded to float64 afterwards.
+*/
+func (opinion Opinion) Divergence(f *sl.Opinion) float64 {
+	pairs := [4][2]*big.Rat{
+		{opinion.Belief(), new(big.Rat).SetFloat64(f.Belief())},
+		{opinion.Disbelief(), new(big.Rat).SetFloat64(f.Disbelief())},
+		{opinion.Uncertainty(), new(big.Rat).SetFloat64(f.Uncertainty())},
+		{opinion.BaseRate(), new(big.Rat).SetFloat64(f.BaseRate())},
+	}
+	max := new(big.Rat)
+	for _, p := range pairs {
+		diff := new(big.Rat).Abs(sub(p[0], p[1]))
+		if diff.Cmp(max) > 0 {
+			max = diff
+		}
+	}
+	divergence, _ := max.Float64()
+	return divergence
+}
+
+/*
+String is called onto an Opinion o and returns its values as fractions, e.g. "3/5, 3/10, 1/10, 1/2".
+*/
+func (opinion Opinion) String() string {
+	return opinion.Belief().RatString() + ", " + opinion.Disbelief().RatString() + ", " +
+		opinion.Uncertainty().RatString() + ", " + opinion.BaseRate().RatString()
+}
+
+func (opinion Opinion) isNull() bool {
+	return opinion.Belief().Sign() == 0 && opinion.Disbelief().Sign() == 0 &&
+		opinion.Uncertainty().Sign() == 0 && opinion.BaseRate().Sign() == 0
+}
+
+/*
+newResult forms the Opinion resulting from the function op from values that are not referenced anywhere else.
+If the values do not form a valid Opinion, a zeroed Opinion and an error wrapping subjectivelogic.ErrInvalidOpinion are returned.
+*/
+func newResult(op string, b, d, u, a *big.Rat, inputs ...*Opinion) (Opinion, error) {
+	for _, v := range [4]*big.Rat{b, d, u, a} {
+		if v.Sign() < 0 || v.Cmp(one) > 0 {
+			return Opinion{}, operatorError(op, sl.ErrInvalidOpinion, "Result is not a valid opinion", inputs...)
+		}
+	}
+	if add(add(b, d), u).Cmp(one) != 0 {
+		return Opinion{}, operatorError(op, sl.ErrInvalidOpinion, "Result is not a valid opinion", inputs...)
+	}
+	return Opinion{belief: b, disbelief: d, uncertainty: u, baseRate: a}, nil
+}
+
+/*
+operatorError returns a *subjectivelogic.OperatorError for the function op, recording the inputs rounded to float64.
+*/
+func operatorError(op string, err error, reason string, inputs ...*Opinion) error {
+	e := &sl.OperatorError{Op: op, Err: err, Reason: reason, Inputs: make([]sl.Opinion, len(inputs))}
+	for i, input := range inputs {
+		if input != nil {
+			e.Inputs[i], _ = input.Float()
+		}
+	}
+	return e
+}
+
+func value(v *big.Rat) *big.Rat {
+	if v == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(v)
+}
+
+func decimal(f float64) *big.Rat {
+	v, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	return v
+}
+
+func add(x, y *big.Rat) *big.Rat {
+	return new(big.Rat).Add(x, y)
+}
+
+func sub(x, y *big.Rat) *big.Rat {
+	return new(big.Rat).Sub(x, y)
+}
+
+func mul(x, y *big.Rat) *big.Rat {
+	return new(big.Rat).Mul(x, y)
+}
+
+func quo(x, y *big.Rat) *big.Rat {
+	return new(big.Rat).Quo(x, y)
+}
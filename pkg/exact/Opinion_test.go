@@ -0,0 +1,119 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package exact
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestParseOpinion(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  [4]string
+		want    string
+		wantErr error
+	}{
+		{"TestParseOpinion1", [4]string{"0.6", "0.3", "0.1", "0.5"}, "3/5, 3/10, 1/10, 1/2", nil},
+		{"TestParseOpinion2", [4]string{"1/3", "1/3", "1/3", "0"}, "1/3, 1/3, 1/3, 0", nil},
+		{"TestParseOpinion3", [4]string{"0.6", "0.3", "0.1000000000000001", "0.5"}, "", sl.ErrInvalidOpinion},
+		{"TestParseOpinion4", [4]string{"1.5", "-0.5", "0", "0.5"}, "", sl.ErrInvalidOpinion},
+		{"TestParseOpinion5", [4]string{"0.5", "0.5", "0", "x"}, "", sl.ErrInvalidOpinion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOpinion(tt.values[0], tt.values[1], tt.values[2], tt.values[3])
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseOpinion() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseOpinion() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewOpinion(t *testing.T) {
+	b := big.NewRat(1, 2)
+	o, err := NewOpinion(b, big.NewRat(1, 4), big.NewRat(1, 4), big.NewRat(1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.SetInt64(1)
+	if o.Belief().Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("NewOpinion() does not copy its inputs, got = %v", o)
+	}
+	o.Belief().SetInt64(1)
+	if o.Belief().Cmp(big.NewRat(1, 2)) != 0 {
+		t.Errorf("Belief() does not return a copy, got = %v", o)
+	}
+	if p := o.ProjectedProbability(); p.Cmp(big.NewRat(5, 8)) != 0 {
+		t.Errorf("ProjectedProbability() got = %v, want 5/8", p)
+	}
+	if _, err := NewOpinion(nil, b, b, b); !errors.Is(err, sl.ErrNilInput) {
+		t.Errorf("NewOpinion() error = %v, want %v", err, sl.ErrNilInput)
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		name string
+		b    float64
+		d    float64
+		u    float64
+		a    float64
+		want string
+	}{
+		{"TestFromFloat1", 0.6, 0.3, 0.1, 0.5, "3/5, 3/10, 1/10, 1/2"},
+		{"TestFromFloat2", 0.7, 0.2, 1 - 0.7 - 0.2, 0, "7/10, 1/5, 1/10, 0"},
+		{"TestFromFloat3", 1.0 / 3, 1.0 / 3, 1.0 / 3, 1, "3333333333333333/10000000000000000, 3333333333333333/10000000000000000, 1666666666666667/5000000000000000, 1"},
+		// the decimals of b and d sum up to slightly more than 1
+		{"TestFromFloat4", 0.7000000000001, 0.3, 0, 0.5, "7000000000001/10000000000001, 3000000000000/10000000000001, 0, 1/2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _ := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+			got, err := FromFloat(&f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("FromFloat() got = %v, want %v", got, tt.want)
+			}
+			back, err := got.Float()
+			if err != nil || !back.Compare(f) {
+				t.Errorf("Float() got = %v, %v, want %v", &back, err, &f)
+			}
+		})
+	}
+	if _, err := FromFloat(nil); !errors.Is(err, sl.ErrNilInput) {
+		t.Errorf("FromFloat() error = %v, want %v", err, sl.ErrNilInput)
+	}
+}
+
+func TestOpinion_Divergence(t *testing.T) {
+	o, _ := ParseOpinion("1/3", "1/3", "1/3", "1/2")
+	f, _ := sl.NewOpinion(0.3, 0.3, 0.4, 0.5)
+	if got := o.Divergence(&f); got < 1.0/15-1e-15 || got > 1.0/15+1e-15 {
+		t.Errorf("Divergence() got = %v, want %v", got, 1.0/15)
+	}
+	g, _ := o.Float()
+	if got := o.Divergence(&g); got == 0 || got > 1e-16 {
+		t.Errorf("Divergence() got = %v, want rounding error of 1/3", got)
+	}
+}
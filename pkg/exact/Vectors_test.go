@@ -0,0 +1,99 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package exact
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+vectorOpinion is an opinion of the shared test vectors, whose numbers are kept as written to be parsed exactly.
+*/
+type vectorOpinion struct {
+	Belief      json.Number `json:"belief"`
+	Disbelief   json.Number `json:"disbelief"`
+	Uncertainty json.Number `json:"uncertainty"`
+	BaseRate    json.Number `json:"base_rate"`
+}
+
+var vectorErrors = map[string]error{
+	"ErrNilInput":       sl.ErrNilInput,
+	"ErrNullOpinion":    sl.ErrNullOpinion,
+	"ErrInvalidOpinion": sl.ErrInvalidOpinion,
+	"ErrUndefined":      sl.ErrUndefined,
+	"ErrTotalConflict":  sl.ErrTotalConflict,
+}
+
+func TestVectors(t *testing.T) {
+	data, err := os.ReadFile("../subjectivelogic/testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type vector struct {
+		Operator string          `json:"operator"`
+		Inputs   []vectorOpinion `json:"inputs"`
+		Exact    []string        `json:"exact"`
+		Error    string          `json:"error"`
+	}
+	var v struct {
+		Published  []vector `json:"published"`
+		Regression []vector `json:"regression"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("invalid vectors: %v", err)
+	}
+	if len(v.Regression) == 0 {
+		t.Fatal("no regression vectors")
+	}
+
+	// published vectors come first, so the indices of the regression vectors follow them
+	for i, tt := range append(v.Published, v.Regression...) {
+		inputs := make([]Opinion, len(tt.Inputs))
+		for j, o := range tt.Inputs {
+			if inputs[j], err = ParseOpinion(o.Belief.String(), o.Disbelief.String(), o.Uncertainty.String(), o.BaseRate.String()); err != nil {
+				t.Fatalf("vector %d: invalid input %d: %v", i, j+1, err)
+			}
+		}
+		var got Opinion
+		switch tt.Operator {
+		case "Complement":
+			got, err = Complement(&inputs[0])
+		case "MultiEdgeTrustDisc":
+			got, err = MultiEdgeTrustDisc(inputs)
+		default:
+			op, ok := operators[tt.Operator]
+			if !ok {
+				t.Errorf("vector %d: unknown operator %q", i, tt.Operator)
+				continue
+			}
+			got, err = op.exact(&inputs[0], &inputs[1])
+		}
+		if tt.Error != "" {
+			if want := vectorErrors[tt.Error]; want == nil || !errors.Is(err, want) {
+				t.Errorf("vector %d (%s): got = %v, %v, want %s", i, tt.Operator, got, err, tt.Error)
+			}
+			continue
+		}
+		want, parseErr := ParseOpinion(tt.Exact[0], tt.Exact[1], tt.Exact[2], tt.Exact[3])
+		if err != nil || parseErr != nil || !got.Equal(want) {
+			t.Errorf("vector %d (%s): got = %v, %v, want %v", i, tt.Operator, got, err, tt.Exact)
+		}
+	}
+}
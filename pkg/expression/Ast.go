@@ -0,0 +1,124 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package expression
+
+import (
+	"strconv"
+	"strings"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Node is a node of the abstract syntax tree of a parsed formula.
+String returns the formula represented by the node, using the infix symbol of an operator wherever one exists.
+*/
+type Node interface {
+	String() string
+	node()
+}
+
+/*
+Ref refers to a named opinion, such as x, ωA or trust(A,B).
+The opinion it refers to is looked up by its Key when the formula is evaluated.
+*/
+type Ref struct {
+	Name string
+	Args []string
+}
+
+/*
+Literal is an opinion written directly into a formula as (b, d, u, a).
+*/
+type Literal struct {
+	Value sl.Opinion
+}
+
+/*
+Call applies the operator Func to the opinions its Args evaluate to.
+Formulas written with infix or prefix symbols, e.g. x ⊕ y or ¬x, are parsed into a Call of the corresponding operator as well.
+*/
+type Call struct {
+	Func string
+	Args []Node
+}
+
+func (Ref) node()     {}
+func (Literal) node() {}
+func (Call) node()    {}
+
+/*
+Key returns the name under which the opinion referred to by r is looked up in the Bindings.
+For references without arguments this is the plain name, otherwise the arguments are appended as in trust(A,B).
+*/
+func (r Ref) Key() string {
+	if len(r.Args) == 0 {
+		return r.Name
+	}
+	return r.Name + "(" + strings.Join(r.Args, ",") + ")"
+}
+
+func (r Ref) String() string {
+	return r.Key()
+}
+
+func (l Literal) String() string {
+	return "(" + formatFloat(l.Value.Belief()) + ", " + formatFloat(l.Value.Disbelief()) + ", " +
+		formatFloat(l.Value.Uncertainty()) + ", " + formatFloat(l.Value.BaseRate()) + ")"
+}
+
+func (c Call) String() string {
+	if symbol := symbols[c.Func]; symbol != "" {
+		switch len(c.Args) {
+		case 1:
+			return symbol + c.Args[0].String()
+		case 2:
+			return "(" + c.Args[0].String() + " " + symbol + " " + c.Args[1].String() + ")"
+		}
+	}
+	args := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		args[i] = arg.String()
+	}
+	return c.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+/*
+Refs returns the keys of all opinions referred to in the formula n, in order of their first occurrence.
+*/
+func Refs(n Node) []string {
+	var keys []string
+	seen := make(map[string]bool)
+	var walk func(Node)
+	walk = func(n Node) {
+		switch n := n.(type) {
+		case Ref:
+			if !seen[n.Key()] {
+				seen[n.Key()] = true
+				keys = append(keys, n.Key())
+			}
+		case Call:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		}
+	}
+	walk(n)
+	return keys
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
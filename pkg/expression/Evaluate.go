@@ -0,0 +1,119 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package expression
+
+import (
+	"errors"
+	"fmt"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Bindings maps the keys of references, e.g. x or trust(A,B), to the opinions they stand for.
+*/
+type Bindings map[string]sl.Opinion
+
+/*
+Evaluation is the result of evaluating a Node, together with the evaluations of the arguments the result was computed from.
+*/
+type Evaluation struct {
+	Node     Node
+	Value    sl.Opinion
+	Children []*Evaluation
+}
+
+/*
+Evaluate takes the abstract syntax tree of a formula and computes the resulting Opinion,
+looking up every referenced opinion in bindings and applying the operators of the subjectivelogic package.
+If a referenced opinion is not bound or an operator fails, a zeroed Opinion and an error are returned.
+*/
+func Evaluate(n Node, bindings Bindings) (sl.Opinion, error) {
+	return Language{}.Evaluate(n, bindings)
+}
+
+/*
+Evaluate is called onto a Language l and evaluates the formula n like the function Evaluate, applying the operators of l.
+*/
+func (l Language) Evaluate(n Node, bindings Bindings) (sl.Opinion, error) {
+	e, err := l.EvaluateTree(n, bindings)
+	if err != nil {
+		return sl.Opinion{}, err
+	}
+	return e.Value, nil
+}
+
+/*
+EvaluateTree evaluates the formula n like Evaluate, but returns the evaluations of all of its nodes
+instead of just the resulting Opinion.
+If the evaluation fails, the *Evaluation will be nil and an error will be returned.
+*/
+func EvaluateTree(n Node, bindings Bindings) (*Evaluation, error) {
+	return Language{}.EvaluateTree(n, bindings)
+}
+
+/*
+EvaluateTree is called onto a Language l and evaluates the formula n like the function EvaluateTree, applying the operators of l.
+*/
+func (l Language) EvaluateTree(n Node, bindings Bindings) (*Evaluation, error) {
+	switch n := n.(type) {
+	case Ref:
+		o, ok := bindings[n.Key()]
+		if !ok {
+			return nil, fmt.Errorf("Evaluate: unbound opinion %q", n.Key())
+		}
+		return &Evaluation{Node: n, Value: o}, nil
+	case Literal:
+		return &Evaluation{Node: n, Value: n.Value}, nil
+	case Call:
+		e := &Evaluation{Node: n, Children: make([]*Evaluation, len(n.Args))}
+		args := make([]sl.Opinion, len(n.Args))
+		for i, arg := range n.Args {
+			child, err := l.EvaluateTree(arg, bindings)
+			if err != nil {
+				return nil, err
+			}
+			e.Children[i] = child
+			args[i] = child.Value
+		}
+		o, err := l.Apply(n.Func, args...)
+		if err != nil {
+			return nil, fmt.Errorf("Evaluate: %s: %w", n, err)
+		}
+		e.Value = o
+		return e, nil
+	case nil:
+		return nil, errors.New("Evaluate: Input cannot be nil")
+	}
+	return nil, fmt.Errorf("Evaluate: unsupported node %T", n)
+}
+
+/*
+EvaluateString parses the formula and evaluates it against bindings in one step.
+*/
+func EvaluateString(formula string, bindings Bindings) (sl.Opinion, error) {
+	return Language{}.EvaluateString(formula, bindings)
+}
+
+/*
+EvaluateString is called onto a Language l and parses and evaluates the formula with the operators of l in one step.
+*/
+func (l Language) EvaluateString(formula string, bindings Bindings) (sl.Opinion, error) {
+	n, err := l.Parse(formula)
+	if err != nil {
+		return sl.Opinion{}, err
+	}
+	return l.Evaluate(n, bindings)
+}
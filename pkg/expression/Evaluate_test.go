@@ -0,0 +1,192 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestEvaluateString(t *testing.T) {
+	var x, y, vacuous, discB, discC, discMulti sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&x, 0.6, 0.3, 0.1, 0},
+		{&y, 0.091, 0.604, 0.305, 0.4},
+		{&vacuous, 0, 0, 1, 0.5},
+		{&discB, 0.0546, 0.3624, 0.583, 0.4},
+		{&discC, 0.3, 0.15, 0.55, 0},
+		{&discMulti, 0.0422058, 0.2801352, 0.677659, 0.4},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	bindings := Bindings{"trust(A,B)": x, "op(B,x)": y, "trust(A,C)": vacuous, "op(C,x)": x, "x": x, "y": y}
+
+	fused, _ := sl.CumulativeFusion(&discB, &discC)
+	complement, _ := sl.Complement(&x)
+	product, _ := sl.Multiplication(&complement, &y)
+
+	tests := []struct {
+		name    string
+		formula string
+		want    sl.Opinion
+		wantErr bool
+	}{
+		{"TestEvaluateString1", "x", x, false},
+		{"TestEvaluateString2", "(0, 0, 1, 0.5)", vacuous, false},
+		{"TestEvaluateString3", "trust(A,B) ⊗ op(B,x)", discB, false},
+		{"TestEvaluateString4", "disc(x, y)", discB, false},
+		{"TestEvaluateString5", "trust(A,B) ⊗ op(B,x) ⊕ trust(A,C) ⊗ op(C,x)", fused, false},
+		{"TestEvaluateString6", "¬x · y", product, false},
+		{"TestEvaluateString7", "disc_multi((0.53, 0.227, 0.243, 1), x, y)", discMulti, false},
+		{"TestEvaluateString8", "z", sl.Opinion{}, true},
+		{"TestEvaluateString9", "x ⊕", sl.Opinion{}, true},
+		{"TestEvaluateString10", "(1, 0, 0, 0.5) + (1, 0, 0, 0.5)", sl.Opinion{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvaluateString(tt.formula, bindings)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EvaluateString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("EvaluateString() got = %v, want %v", &got, &tt.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	x, err := sl.NewOpinion(0.6, 0.3, 0.1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := sl.NewOpinion(0.3, 0.6, 0.1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Apply("fuse_cum", x); err == nil {
+		t.Errorf("Apply() with missing input passed undetected")
+	}
+	if _, err := Apply("unknown", x, x); err == nil {
+		t.Errorf("Apply() with unknown operator passed undetected")
+	}
+	got, err := Apply("not", x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Compare(want) {
+		t.Errorf("Apply() got = %v, want %v", &got, &want)
+	}
+}
+
+func TestRegisteredOperator(t *testing.T) {
+	r := sl.NewStandardRegistry()
+	if err := r.RegisterBinary("fuse_cum_uf", sl.BinaryFunc(func(o1, o2 *sl.Opinion) (sl.Opinion, error) {
+		o, err := sl.CumulativeFusion(o1, o2)
+		if err != nil {
+			return sl.Opinion{}, err
+		}
+		return sl.TrustDiscountingUncertaintyFavouring(o1, &o)
+	})); err != nil {
+		t.Fatal(err)
+	}
+	l := Language{Registry: r}
+
+	x, err := sl.NewOpinion(0.6, 0.3, 0.1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := sl.NewOpinion(0.091, 0.604, 0.305, 0.4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := l.EvaluateString("fuse_cum_uf(x, y)", Bindings{"x": x, "y": y})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fused, _ := sl.CumulativeFusion(&x, &y)
+	if want, _ := sl.TrustDiscountingUncertaintyFavouring(&x, &fused); !got.Compare(want) {
+		t.Errorf("EvaluateString() got = %v, want %v", &got, &want)
+	}
+	if _, err := l.Parse("fuse_cum_uf(x)"); err == nil {
+		t.Errorf("Parse() with missing argument passed undetected")
+	}
+
+	// without the registry, the same formula refers to an opinion, even if the operator is registered globally
+	if err := sl.DefaultRegistry.RegisterBinary("fuse_cum_uf", sl.BinaryFunc(sl.CumulativeFusion)); err != nil {
+		t.Fatal(err)
+	}
+	defer sl.DefaultRegistry.Unregister("fuse_cum_uf")
+	n, err := Parse("fuse_cum_uf(x, y)")
+	if _, ok := n.(Ref); err != nil || !ok {
+		t.Errorf("Parse() got = %#v, %v, want a reference", n, err)
+	}
+	if got := Functions(); len(got) != len(sl.NewStandardRegistry().Names()) {
+		t.Errorf("Functions() got = %v, want the standard operators", got)
+	}
+}
+
+func TestEvaluateTree(t *testing.T) {
+	var x, y, disc sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&x, 0.6, 0.3, 0.1, 0},
+		{&y, 0.091, 0.604, 0.305, 0.4},
+		{&disc, 0.0546, 0.3624, 0.583, 0.4},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	bindings := Bindings{"x": x, "y": y}
+	n, err := Parse("¬(x ⊗ y) ⊕ x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := EvaluateTree(n, bindings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := Evaluate(n, bindings)
+	if !e.Value.Compare(want) || e.Node.String() != n.String() {
+		t.Errorf("EvaluateTree() got = %v, want %v", &e.Value, &want)
+	}
+	if len(e.Children) != 2 || len(e.Children[0].Children) != 1 || len(e.Children[0].Children[0].Children) != 2 {
+		t.Fatalf("EvaluateTree() returned a tree of the wrong shape")
+	}
+	if child := e.Children[0].Children[0]; !child.Value.Compare(disc) || child.Node.String() != "(x ⊗ y)" {
+		t.Errorf("EvaluateTree() got = %v for %v, want %v", &child.Value, child.Node, &disc)
+	}
+	if leaf := e.Children[1]; leaf.Node.String() != "x" || leaf.Children != nil {
+		t.Errorf("EvaluateTree() got = %v as leaf", leaf.Node)
+	}
+
+	if _, err := EvaluateTree(n, Bindings{"x": bindings["x"]}); err == nil {
+		t.Errorf("EvaluateTree() with unbound opinion passed undetected")
+	}
+}
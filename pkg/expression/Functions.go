@@ -0,0 +1,90 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package expression
+
+import (
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+symbols holds the infix or prefix symbols of the operators that have one in Subjective Logic, keyed by the name of the operator.
+*/
+var symbols = map[string]string{
+	"add":      "+",
+	"not":      "¬",
+	"mul":      "·",
+	"comul":    "⊔",
+	"fuse_cum": "⊕",
+	"disc":     "⊗",
+}
+
+/*
+standard holds the operators of the zero Language. Unlike subjectivelogic.DefaultRegistry it is never modified,
+so whether name(...) in a formula is a call or a reference does not depend on operators registered elsewhere.
+*/
+var standard = sl.NewStandardRegistry()
+
+/*
+Language parses and evaluates formulas with the operators of Registry, e.g. subjectivelogic.DefaultRegistry
+or a registry holding additional operators. Its methods are otherwise identical to the functions of the same name.
+The zero value uses the operators of subjectivelogic.NewStandardRegistry.
+*/
+type Language struct {
+	Registry *sl.Registry
+}
+
+func (l Language) registry() *sl.Registry {
+	if l.Registry == nil {
+		return standard
+	}
+	return l.Registry
+}
+
+/*
+Functions returns the names of all operators of subjectivelogic.NewStandardRegistry, which can be called from a formula, sorted alphabetically.
+*/
+func Functions() []string {
+	return Language{}.Functions()
+}
+
+/*
+Functions is called onto a Language l and returns the names of all operators that can be called from a formula of l, sorted alphabetically.
+*/
+func (l Language) Functions() []string {
+	return l.registry().Names()
+}
+
+/*
+Symbol returns the infix or prefix symbol of the operator with the given name, or an empty string if it has none.
+*/
+func Symbol(name string) string {
+	return symbols[name]
+}
+
+/*
+Apply calls the operator with the given name on the input opinions.
+An error is returned if no such operator exists, if the number of inputs does not match the operator,
+or if the operator itself fails.
+*/
+func Apply(name string, args ...sl.Opinion) (sl.Opinion, error) {
+	return Language{}.Apply(name, args...)
+}
+
+/*
+Apply is called onto a Language l and calls the operator of l with the given name on the input opinions like the function Apply.
+*/
+func (l Language) Apply(name string, args ...sl.Opinion) (sl.Opinion, error) {
+	return l.registry().Apply(name, args...)
+}
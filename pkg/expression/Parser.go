@@ -0,0 +1,307 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+type tokenKind int
+
+const (
+	tEOF tokenKind = iota
+	tIdent
+	tNumber
+	tSymbol
+	tLParen
+	tRParen
+	tComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tEOF {
+		return "end of formula"
+	}
+	return strconv.Quote(t.text)
+}
+
+/*
+prefixSymbols and infixSymbols map the symbols that may be used in a formula to the name of the operator they stand for.
+Infix symbols of the first map bind stronger than those of the second one.
+*/
+var prefixSymbols = map[string]string{"¬": "not", "!": "not"}
+
+var infixSymbols = []map[string]string{
+	{"⊗": "disc", "·": "mul", "⊔": "comul"},
+	{"⊕": "fuse_cum", "+": "add"},
+}
+
+func lex(formula string) ([]token, error) {
+	var tokens []token
+	runes := []rune(formula)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{tLParen, "(", i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{tRParen, ")", i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{tComma, ",", i})
+			i++
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{tIdent, string(runes[start:i]), start})
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' ||
+				(runes[i] == '-' && runes[i-1] == 'e')) {
+				i++
+			}
+			tokens = append(tokens, token{tNumber, string(runes[start:i]), start})
+		default:
+			if !isSymbol(string(r)) {
+				return nil, fmt.Errorf("Parse: unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, token{tSymbol, string(r), i})
+			i++
+		}
+	}
+	return append(tokens, token{tEOF, "", len(runes)}), nil
+}
+
+func isSymbol(s string) bool {
+	if _, ok := prefixSymbols[s]; ok {
+		return true
+	}
+	for _, level := range infixSymbols {
+		if _, ok := level[s]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+type parser struct {
+	tokens   []token
+	pos      int
+	registry *sl.Registry
+}
+
+/*
+Parse takes a formula such as trust(A,B) ⊗ op(B,x) ⊕ trust(A,C) ⊗ op(C,x) and returns its abstract syntax tree.
+Operators can be written as function calls, e.g. fuse_cum(x, y), or, where Subjective Logic defines one, with their symbol:
+¬ (or !) for Complement, ⊗ for TrustDiscounting, · for Multiplication, ⊔ for Comultiplication,
+⊕ for CumulativeFusion and + for Addition. ¬ binds strongest, followed by ⊗, · and ⊔, followed by ⊕ and +.
+Any other name refers to an opinion, optionally followed by a list of names as in trust(A,B).
+Opinions can also be written directly as (b, d, u, a).
+A name followed by a list is a call if it names an operator of subjectivelogic.NewStandardRegistry.
+If the formula is malformed, the Node will be nil and an error will be returned.
+*/
+func Parse(formula string) (Node, error) {
+	return Language{}.Parse(formula)
+}
+
+/*
+Parse is called onto a Language l and parses the formula like the function Parse, treating every operator of l as a function.
+*/
+func (l Language) Parse(formula string) (Node, error) {
+	tokens, err := lex(formula)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, registry: l.registry()}
+	n, err := p.parseInfix(len(infixSymbols) - 1)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tEOF {
+		return nil, fmt.Errorf("Parse: unexpected %s at position %d", t, t.pos)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, fmt.Errorf("Parse: expected %s at position %d, got %s", what, t.pos, t)
+	}
+	return t, nil
+}
+
+func (p *parser) parseInfix(level int) (Node, error) {
+	if level < 0 {
+		return p.parseUnary()
+	}
+	left, err := p.parseInfix(level - 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		name, ok := infixSymbols[level][t.text]
+		if t.kind != tSymbol || !ok {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseInfix(level - 1)
+		if err != nil {
+			return nil, err
+		}
+		left = Call{Func: name, Args: []Node{left, right}}
+	}
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	t := p.peek()
+	if name, ok := prefixSymbols[t.text]; ok && t.kind == tSymbol {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Call{Func: name, Args: []Node{operand}}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Node, error) {
+	t := p.next()
+	switch t.kind {
+	case tIdent:
+		if p.peek().kind != tLParen {
+			return Ref{Name: t.text}, nil
+		}
+		p.next()
+		if _, _, ok := p.registry.Arity(t.text); ok {
+			return p.parseCall(t.text)
+		}
+		return p.parseRef(t.text)
+	case tLParen:
+		if p.peek().kind == tNumber {
+			return p.parseLiteral()
+		}
+		n, err := p.parseInfix(len(infixSymbols) - 1)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tRParen, "\")\""); err != nil {
+			return nil, err
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("Parse: unexpected %s at position %d", t, t.pos)
+}
+
+func (p *parser) parseCall(name string) (Node, error) {
+	call := Call{Func: name}
+	for {
+		arg, err := p.parseInfix(len(infixSymbols) - 1)
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+		if p.peek().kind != tComma {
+			break
+		}
+		p.next()
+	}
+	t, err := p.expect(tRParen, "\")\"")
+	if err != nil {
+		return nil, err
+	}
+
+	arity, minArity, _ := p.registry.Arity(name)
+	if (arity >= 0 && len(call.Args) != arity) || (arity < 0 && len(call.Args) < minArity) {
+		return nil, fmt.Errorf("Parse: wrong number of arguments for %s at position %d", name, t.pos)
+	}
+	return call, nil
+}
+
+func (p *parser) parseRef(name string) (Node, error) {
+	ref := Ref{Name: name}
+	for {
+		t, err := p.expect(tIdent, "name")
+		if err != nil {
+			return nil, err
+		}
+		ref.Args = append(ref.Args, t.text)
+		if p.peek().kind != tComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tRParen, "\")\""); err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
+
+func (p *parser) parseLiteral() (Node, error) {
+	var values [4]float64
+	for i := range values {
+		if i > 0 {
+			if _, err := p.expect(tComma, "\",\""); err != nil {
+				return nil, err
+			}
+		}
+		t, err := p.expect(tNumber, "number")
+		if err != nil {
+			return nil, err
+		}
+		values[i], err = strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Parse: invalid number %s at position %d", t, t.pos)
+		}
+	}
+	t, err := p.expect(tRParen, "\")\"")
+	if err != nil {
+		return nil, err
+	}
+	o, err := sl.NewOpinion(values[0], values[1], values[2], values[3])
+	if err != nil {
+		return nil, fmt.Errorf("Parse: invalid opinion ending at position %d", t.pos)
+	}
+	return Literal{Value: o}, nil
+}
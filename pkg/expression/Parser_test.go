@@ -0,0 +1,90 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package expression
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula string
+		want    string
+		wantErr bool
+	}{
+		//references
+		{"TestParse1", "x", "x", false},
+		{"TestParse2", "ωA", "ωA", false},
+		{"TestParse3", "trust(A, B)", "trust(A,B)", false},
+
+		//literals
+		{"TestParse4", "(0.5, 0.25, 0.25, 0.5)", "(0.5, 0.25, 0.25, 0.5)", false},
+		{"TestParse5", "(1e-1, 0.9, 0, 1)", "(0.1, 0.9, 0, 1)", false},
+
+		//operators
+		{"TestParse6", "x ⊕ y", "(x ⊕ y)", false},
+		{"TestParse7", "fuse_cum(x, y)", "(x ⊕ y)", false},
+		{"TestParse8", "fuse_avg(x, y)", "fuse_avg(x, y)", false},
+		{"TestParse9", "¬x", "¬x", false},
+		{"TestParse10", "!x", "¬x", false},
+		{"TestParse11", "disc_multi(x, y, z)", "disc_multi(x, y, z)", false},
+
+		//precedence and associativity
+		{"TestParse12", "trust(A,B) ⊗ op(B,x) ⊕ trust(A,C) ⊗ op(C,x)",
+			"((trust(A,B) ⊗ op(B,x)) ⊕ (trust(A,C) ⊗ op(C,x)))", false},
+		{"TestParse13", "x ⊗ (y ⊕ z)", "(x ⊗ (y ⊕ z))", false},
+		{"TestParse14", "x + y + z", "((x + y) + z)", false},
+		{"TestParse15", "¬x · y", "(¬x · y)", false},
+		{"TestParse16", "¬(x ⊔ y)", "¬(x ⊔ y)", false},
+
+		//malformed formulas
+		{"TestParse17", "", "", true},
+		{"TestParse18", "x ⊕", "", true},
+		{"TestParse19", "x y", "", true},
+		{"TestParse20", "(x ⊕ y", "", true},
+		{"TestParse21", "trust(A, x ⊕ y)", "", true},
+		{"TestParse22", "fuse_cum(x)", "", true},
+		{"TestParse23", "disc_multi(x)", "", true},
+		{"TestParse24", "(0.5, 0.5, 0.5, 0.5)", "", true},
+		{"TestParse25", "(0.5, 0.5, 0)", "", true},
+		{"TestParse26", "x $ y", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.formula)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefs(t *testing.T) {
+	n, err := Parse("trust(A,B) ⊗ x ⊕ trust(A,C) ⊗ x ⊕ (0, 0, 1, 0.5)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := Refs(n)
+	want := []string{"trust(A,B)", "x", "trust(A,C)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Refs() got = %v, want %v", got, want)
+	}
+}
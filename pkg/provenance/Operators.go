@@ -0,0 +1,118 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package provenance
+
+import (
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Addition traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func Addition(x, y *Node) (*Node, error) {
+	return standard(Binary("add", sl.Addition, x, y))
+}
+
+/*
+Complement traces the subjectivelogic function of the same name applied to the opinion of x.
+*/
+func Complement(x *Node) (*Node, error) {
+	return standard(Unary("not", sl.Complement, x))
+}
+
+/*
+Multiplication traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func Multiplication(x, y *Node) (*Node, error) {
+	return standard(Binary("mul", sl.Multiplication, x, y))
+}
+
+/*
+Comultiplication traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func Comultiplication(x, y *Node) (*Node, error) {
+	return standard(Binary("comul", sl.Comultiplication, x, y))
+}
+
+/*
+ConstraintFusion traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func ConstraintFusion(x, y *Node) (*Node, error) {
+	return standard(Binary("fuse_con", sl.ConstraintFusion, x, y))
+}
+
+/*
+CumulativeFusion traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func CumulativeFusion(x, y *Node) (*Node, error) {
+	return standard(Binary("fuse_cum", sl.CumulativeFusion, x, y))
+}
+
+/*
+AveragingFusion traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func AveragingFusion(x, y *Node) (*Node, error) {
+	return standard(Binary("fuse_avg", sl.AveragingFusion, x, y))
+}
+
+/*
+WeightedFusion traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func WeightedFusion(x, y *Node) (*Node, error) {
+	return standard(Binary("fuse_wgt", sl.WeightedFusion, x, y))
+}
+
+/*
+TrustDiscounting traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func TrustDiscounting(x, y *Node) (*Node, error) {
+	return standard(Binary("disc", sl.TrustDiscounting, x, y))
+}
+
+/*
+TrustDiscountingOppositeBelief traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func TrustDiscountingOppositeBelief(x, y *Node) (*Node, error) {
+	return standard(Binary("disc_ob", sl.TrustDiscountingOppositeBelief, x, y))
+}
+
+/*
+TrustDiscountingUncertaintyFavouring traces the subjectivelogic function of the same name applied to the opinions of x and y.
+*/
+func TrustDiscountingUncertaintyFavouring(x, y *Node) (*Node, error) {
+	return standard(Binary("disc_uf", sl.TrustDiscountingUncertaintyFavouring, x, y))
+}
+
+/*
+MultiEdgeTrustDisc traces the subjectivelogic function of the same name applied to the opinions of all inputs.
+*/
+func MultiEdgeTrustDisc(inputs ...*Node) (*Node, error) {
+	return standard(Nary("disc_multi", sl.MultiEdgeTrustDisc, inputs...))
+}
+
+/*
+standard records the intermediate values of the standard operator of the traced Node n, unless one of its inputs failed.
+*/
+func standard(n *Node, err error) (*Node, error) {
+	opinions := make([]sl.Opinion, len(n.Inputs))
+	for i, input := range n.Inputs {
+		if input == nil || input.Opinion == nil {
+			return n, err
+		}
+		opinions[i] = *input.Opinion
+	}
+	n.Intermediates = sl.Intermediates(n.Operator, opinions...)
+	return n, err
+}
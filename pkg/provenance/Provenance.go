@@ -0,0 +1,186 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package provenance traces how opinions are derived. The operators of this package wrap those of the subjectivelogic package
+and return a Node that carries the result together with the operator and the nodes of its inputs, so that the complete computation
+behind a surprising result can be inspected, serialised to JSON or pretty-printed.
+Operators are recorded under their names in subjectivelogic.NewStandardRegistry, e.g. "fuse_cum" for CumulativeFusion,
+which are also the names of the functions of the expression language used by FromEvaluation.
+*/
+package provenance
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Node is a step of a traced computation. Inputs have a Label and no Operator, results of operators have the name of the Operator
+and the nodes of their Inputs. If the operator failed, Opinion is nil and Error holds the message of the error.
+Intermediates holds the values the operator derived from its inputs on the way to its result, as returned by subjectivelogic.Intermediates,
+e.g. the conflict of the inputs of ConstraintFusion; it is also kept if the operator failed on its inputs.
+*/
+type Node struct {
+	Operator             string             `json:"operator,omitempty"`
+	Label                string             `json:"label,omitempty"`
+	Opinion              *sl.Opinion        `json:"opinion,omitempty"`
+	ProjectedProbability float64            `json:"projected_probability"`
+	Intermediates        map[string]float64 `json:"intermediates,omitempty"`
+	Error                string             `json:"error,omitempty"`
+	Inputs               []*Node            `json:"inputs,omitempty"`
+}
+
+/*
+Input returns a leaf Node for the opinion o, which is an input of a computation and is described by label.
+*/
+func Input(label string, o sl.Opinion) *Node {
+	return &Node{Label: label, Opinion: &o, ProjectedProbability: o.ProjectedProbability()}
+}
+
+/*
+Unary applies the operator op, which is recorded under name, to the opinion of the Node x and returns the traced result.
+If x or the operator failed, the returned Node records the failure and an error is returned as well.
+*/
+func Unary(name string, op func(*sl.Opinion) (sl.Opinion, error), x *Node) (*Node, error) {
+	return Nary(name, func(opinions []sl.Opinion) (sl.Opinion, error) {
+		return op(&opinions[0])
+	}, x)
+}
+
+/*
+Binary applies the operator op, which is recorded under name, to the opinions of the Nodes x and y and returns the traced result.
+If x, y or the operator failed, the returned Node records the failure and an error is returned as well.
+*/
+func Binary(name string, op func(*sl.Opinion, *sl.Opinion) (sl.Opinion, error), x, y *Node) (*Node, error) {
+	return Nary(name, func(opinions []sl.Opinion) (sl.Opinion, error) {
+		return op(&opinions[0], &opinions[1])
+	}, x, y)
+}
+
+/*
+Nary applies the operator op, which is recorded under name, to the opinions of all inputs and returns the traced result.
+If an input or the operator failed, the returned Node records the failure and an error is returned as well.
+*/
+func Nary(name string, op func([]sl.Opinion) (sl.Opinion, error), inputs ...*Node) (*Node, error) {
+	n := &Node{Operator: name, Inputs: inputs}
+	opinions := make([]sl.Opinion, len(inputs))
+	for i, input := range inputs {
+		if input == nil {
+			return n.fail(fmt.Errorf("%s: Input cannot be nil", name))
+		}
+		if input.Opinion == nil {
+			return n.fail(fmt.Errorf("%s: input %d failed: %s", name, i+1, input.Error))
+		}
+		opinions[i] = *input.Opinion
+	}
+	o, err := op(opinions)
+	if err != nil {
+		return n.fail(err)
+	}
+	n.Opinion = &o
+	n.ProjectedProbability = o.ProjectedProbability()
+	return n, nil
+}
+
+func (n *Node) fail(err error) (*Node, error) {
+	n.Error = err.Error()
+	return n, err
+}
+
+/*
+FromEvaluation converts an evaluated formula of the expression package into a Node.
+References become inputs labelled with their key, calls become operators named like the functions of the expression language.
+As a formula may be evaluated with any registry, the operators of its calls are not known and their nodes carry no Intermediates.
+*/
+func FromEvaluation(e *expression.Evaluation) (*Node, error) {
+	if e == nil {
+		return nil, errors.New("FromEvaluation: Input cannot be nil")
+	}
+	var convert func(e *expression.Evaluation) *Node
+	convert = func(e *expression.Evaluation) *Node {
+		call, ok := e.Node.(expression.Call)
+		if !ok {
+			label := e.Node.String()
+			if _, ok := e.Node.(expression.Literal); ok {
+				label = "literal"
+			}
+			return Input(label, e.Value)
+		}
+		o := e.Value
+		n := &Node{Operator: call.Func, Opinion: &o, ProjectedProbability: o.ProjectedProbability()}
+		for _, child := range e.Children {
+			n.Inputs = append(n.Inputs, convert(child))
+		}
+		return n
+	}
+	return convert(e), nil
+}
+
+/*
+String is called onto a *Node n and pretty-prints the computation of n as an indented tree, with one line per step.
+*/
+func (n *Node) String() string {
+	var sb strings.Builder
+	n.write(&sb, "", "")
+	return sb.String()
+}
+
+func (n *Node) write(sb *strings.Builder, first, rest string) {
+	sb.WriteString(first)
+	if n == nil {
+		sb.WriteString("<nil>\n")
+		return
+	}
+	if n.Operator != "" {
+		sb.WriteString(n.Operator)
+	} else {
+		sb.WriteString(n.Label)
+	}
+	if n.Opinion != nil {
+		o := n.Opinion
+		fmt.Fprintf(sb, ": (%.4g, %.4g, %.4g, %.4g) P = %.4g", o.Belief(), o.Disbelief(), o.Uncertainty(), o.BaseRate(), n.ProjectedProbability)
+	} else {
+		fmt.Fprintf(sb, ": error: %s", n.Error)
+	}
+	if len(n.Intermediates) > 0 {
+		names := make([]string, 0, len(n.Intermediates))
+		for name := range n.Intermediates {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for i, name := range names {
+			if i == 0 {
+				sb.WriteString(" [")
+			} else {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(sb, "%s = %.4g", name, n.Intermediates[name])
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("\n")
+	for i, input := range n.Inputs {
+		if i == len(n.Inputs)-1 {
+			input.write(sb, rest+"└── ", rest+"    ")
+		} else {
+			input.write(sb, rest+"├── ", rest+"│   ")
+		}
+	}
+}
@@ -0,0 +1,291 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package provenance
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestTrace(t *testing.T) {
+	var tAB, oBx, oAx sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&tAB, 0.6, 0.3, 0.1, 0.5},
+		{&oBx, 0.2, 0.3, 0.5, 0.5},
+		{&oAx, 0.5, 0.5, 0, 0.5},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	trust, x, y := Input("trust(A,B)", tAB), Input("op(B,x)", oBx), Input("op(A,x)", oAx)
+
+	discounted, err := TrustDiscounting(trust, x)
+	if err != nil {
+		t.Fatalf("TrustDiscounting() error = %v", err)
+	}
+	fused, err := CumulativeFusion(discounted, y)
+	if err != nil {
+		t.Fatalf("CumulativeFusion() error = %v", err)
+	}
+
+	want, _ := sl.TrustDiscounting(trust.Opinion, x.Opinion)
+	if *discounted.Opinion != want || discounted.ProjectedProbability != want.ProjectedProbability() {
+		t.Errorf("TrustDiscounting() got = %v, want %v", discounted.Opinion, &want)
+	}
+	want, _ = sl.CumulativeFusion(&want, y.Opinion)
+	if *fused.Opinion != want {
+		t.Errorf("CumulativeFusion() got = %v, want %v", fused.Opinion, &want)
+	}
+	if fused.Operator != "fuse_cum" || len(fused.Inputs) != 2 || fused.Inputs[0] != discounted || fused.Inputs[1] != y {
+		t.Errorf("CumulativeFusion() does not record its inputs, got = %+v", fused)
+	}
+
+	// the trust opinion discounts by its projected probability, the cumulative fusion normalises by 0.675 + 0 - 0.675 * 0
+	if got := discounted.Intermediates; len(got) != 1 || got["trust_probability"] != tAB.ProjectedProbability() {
+		t.Errorf("TrustDiscounting() got intermediates %v, want trust_probability = %v", got, tAB.ProjectedProbability())
+	}
+	if got := fused.Intermediates; len(got) != 1 || got["denominator"] != 0.675 {
+		t.Errorf("CumulativeFusion() got intermediates %v, want denominator = 0.675", got)
+	}
+	if trust.Intermediates != nil {
+		t.Errorf("Input() got intermediates %v, want none", trust.Intermediates)
+	}
+
+	wantString := "fuse_cum: (0.5, 0.5, 0, 0.5) P = 0.5 [denominator = 0.675]\n" +
+		"├── disc: (0.13, 0.195, 0.675, 0.5) P = 0.4675 [trust_probability = 0.65]\n" +
+		"│   ├── trust(A,B): (0.6, 0.3, 0.1, 0.5) P = 0.65\n" +
+		"│   └── op(B,x): (0.2, 0.3, 0.5, 0.5) P = 0.45\n" +
+		"└── op(A,x): (0.5, 0.5, 0, 0.5) P = 0.5\n"
+	if got := fused.String(); got != wantString {
+		t.Errorf("String() got =\n%s\nwant\n%s", got, wantString)
+	}
+
+	data, err := json.Marshal(fused)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decoded Node
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if decoded.String() != wantString {
+		t.Errorf("JSON round trip got =\n%s\nwant\n%s", decoded.String(), wantString)
+	}
+}
+
+func TestTraceError(t *testing.T) {
+	var belief, disbelief sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&belief, 1, 0, 0, 0.5},
+		{&disbelief, 0, 1, 0, 0.5},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	x, y := Input("x", belief), Input("y", disbelief)
+
+	conflict, err := ConstraintFusion(x, y)
+	if !errors.Is(err, sl.ErrTotalConflict) {
+		t.Errorf("ConstraintFusion() error = %v, want %v", err, sl.ErrTotalConflict)
+	}
+	if conflict == nil || conflict.Opinion != nil || conflict.Error != err.Error() || len(conflict.Inputs) != 2 {
+		t.Fatalf("ConstraintFusion() does not record the failure, got = %+v", conflict)
+	}
+	// the intermediate values show why the operator failed
+	if got := conflict.Intermediates; len(got) != 2 || got["harmony"] != 0 || got["conflict"] != 1 {
+		t.Errorf("ConstraintFusion() got intermediates %v, want harmony = 0, conflict = 1", got)
+	}
+
+	// failures propagate to the nodes computed from them
+	complement, err := Complement(conflict)
+	if err == nil || complement.Opinion != nil || complement.Inputs[0] != conflict || complement.Intermediates != nil {
+		t.Errorf("Complement() of a failed node got = %+v, %v", complement, err)
+	}
+	if _, err := Complement(nil); err == nil {
+		t.Errorf("Complement() of nil does not fail")
+	}
+}
+
+func TestOperatorNames(t *testing.T) {
+	var ox, oy sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&ox, 0.2, 0.1, 0.7, 0.3},
+		{&oy, 0.1, 0.3, 0.6, 0.4},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	x, y := Input("x", ox), Input("y", oy)
+	unary := map[string]func(*Node) (*Node, error){"not": Complement}
+	binary := map[string]func(*Node, *Node) (*Node, error){
+		"add":      Addition,
+		"mul":      Multiplication,
+		"comul":    Comultiplication,
+		"fuse_con": ConstraintFusion,
+		"fuse_cum": CumulativeFusion,
+		"fuse_avg": AveragingFusion,
+		"fuse_wgt": WeightedFusion,
+		"disc":     TrustDiscounting,
+		"disc_ob":  TrustDiscountingOppositeBelief,
+		"disc_uf":  TrustDiscountingUncertaintyFavouring,
+	}
+	check := func(name string, n *Node, inputs ...*Node) {
+		opinions := make([]sl.Opinion, len(inputs))
+		for i, input := range inputs {
+			opinions[i] = *input.Opinion
+		}
+		want, err := sl.NewStandardRegistry().Apply(name, opinions...)
+		if n.Operator != name || err != nil || n.Opinion == nil || *n.Opinion != want {
+			t.Errorf("%s() got = %+v, want %v, %v", name, n, &want, err)
+		}
+		if intermediates := sl.Intermediates(name, opinions...); !reflect.DeepEqual(n.Intermediates, intermediates) {
+			t.Errorf("%s() got intermediates %v, want %v", name, n.Intermediates, intermediates)
+		}
+	}
+	for name, op := range unary {
+		n, _ := op(x)
+		check(name, n, x)
+	}
+	for name, op := range binary {
+		n, _ := op(x, y)
+		check(name, n, x, y)
+	}
+	n, _ := MultiEdgeTrustDisc(x, y, x)
+	check("disc_multi", n, x, y, x)
+}
+
+func TestIntermediates(t *testing.T) {
+	var ox, oy sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&ox, 0.6, 0.3, 0.1, 0.5},
+		{&oy, 0.2, 0.3, 0.5, 0.5},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	x, y := Input("x", ox), Input("y", oy)
+
+	// harmony = 0.6*0.5 + 0.2*0.1 + 0.6*0.2 and conflict = 0.6*0.3 + 0.2*0.3, which form the fused belief
+	fused, err := ConstraintFusion(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	harmony, conflict := fused.Intermediates["harmony"], fused.Intermediates["conflict"]
+	if math.Abs(harmony-0.44) > 1e-12 || math.Abs(conflict-0.24) > 1e-12 {
+		t.Errorf("ConstraintFusion() got intermediates %v, want harmony = 0.44, conflict = 0.24", fused.Intermediates)
+	}
+	if b := harmony / (1 - conflict); math.Abs(fused.Opinion.Belief()-b) > 1e-12 {
+		t.Errorf("ConstraintFusion() got belief %v, want harmony / (1 - conflict) = %v", fused.Opinion.Belief(), b)
+	}
+
+	// the projected probability of the trust opinion scales the belief and disbelief of the discounted opinion
+	discounted, err := TrustDiscounting(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := discounted.Intermediates["trust_probability"]
+	if math.Abs(p-0.65) > 1e-12 || math.Abs(discounted.Opinion.Belief()-p*0.2) > 1e-12 || math.Abs(discounted.Opinion.Disbelief()-p*0.3) > 1e-12 {
+		t.Errorf("TrustDiscounting() got = %v with intermediates %v, want trust_probability = 0.65", discounted.Opinion, discounted.Intermediates)
+	}
+
+	// operators without intermediate values and generic wrappers record none
+	if n, _ := Complement(x); n.Intermediates != nil {
+		t.Errorf("Complement() got intermediates %v, want none", n.Intermediates)
+	}
+	if n, _ := Binary("fuse_con", sl.ConstraintFusion, x, y); n.Intermediates != nil {
+		t.Errorf("Binary() got intermediates %v, want none", n.Intermediates)
+	}
+
+	data, err := json.Marshal(fused)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decoded Node
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded.Intermediates, fused.Intermediates) {
+		t.Errorf("JSON round trip got intermediates %v, %v, want %v", decoded.Intermediates, err, fused.Intermediates)
+	}
+}
+
+func TestFromEvaluation(t *testing.T) {
+	var tAB, oBx sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&tAB, 0.6, 0.3, 0.1, 0.5},
+		{&oBx, 0.2, 0.3, 0.5, 0.5},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	bindings := expression.Bindings{"trust(A,B)": tAB, "op(B,x)": oBx}
+	formula, err := expression.Parse("¬(trust(A,B) ⊗ op(B,x))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := expression.EvaluateTree(formula, bindings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := FromEvaluation(e)
+	if err != nil {
+		t.Fatalf("FromEvaluation() error = %v", err)
+	}
+
+	trust, x := Input("trust(A,B)", bindings["trust(A,B)"]), Input("op(B,x)", bindings["op(B,x)"])
+	// the operators of the calls record no intermediate values
+	discounted, _ := Binary("disc", sl.TrustDiscounting, trust, x)
+	want, _ := Unary("not", sl.Complement, discounted)
+	if got.String() != want.String() {
+		t.Errorf("FromEvaluation() got =\n%s\nwant\n%s", got, want)
+	}
+
+	if _, err := FromEvaluation(nil); err == nil {
+		t.Errorf("FromEvaluation() of nil does not fail")
+	}
+}
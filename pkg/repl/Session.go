@@ -0,0 +1,271 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package repl
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Prompt is printed by Run before reading each line of input.
+*/
+const Prompt = "sl> "
+
+const help = `name = formula          bind the result of a formula to a name, e.g. ω1 = fuse_cum(ωA, ωB)
+formula                 evaluate a formula and print the result
+:evidence name r s a    bind the opinion formed from evidence r, s and base rate a to a name
+:vars                   list all bound opinions
+:del name               remove a bound opinion
+:save file              save all bound opinions to a JSON file
+:load file              load bound opinions from a JSON file
+:ops                    list the available operators
+:help                   print this help
+:quit                   leave the session
+`
+
+/*
+ErrQuit is returned by Execute when the session is to be terminated.
+*/
+var ErrQuit = errors.New("quit")
+
+/*
+language evaluates the formulas of a session, so that operators registered with subjectivelogic.DefaultRegistry can be called.
+*/
+var language = expression.Language{Registry: sl.DefaultRegistry}
+
+/*
+Session is an interactive Subjective Logic session that binds names to opinions.
+Formulas are evaluated with the expression package, so results match those of the operators of the subjectivelogic package.
+*/
+type Session struct {
+	Bindings expression.Bindings
+}
+
+/*
+NewSession returns a *Session without any bound opinions.
+*/
+func NewSession() *Session {
+	return &Session{Bindings: expression.Bindings{}}
+}
+
+/*
+Run reads lines from in, executes them and writes the results to out until in is exhausted or :quit is entered.
+Errors of single lines are printed to out and do not terminate the session.
+*/
+func (session *Session) Run(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, Prompt)
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		output, err := session.Execute(scanner.Text())
+		if errors.Is(err, ErrQuit) {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintln(out, "error:", err)
+			continue
+		}
+		fmt.Fprint(out, output)
+	}
+}
+
+/*
+Execute executes a single line of input and returns the output to be printed.
+ErrQuit is returned if the line asks to terminate the session.
+*/
+func (session *Session) Execute(line string) (string, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return "", nil
+	}
+	if strings.HasPrefix(line, ":") {
+		return session.command(line)
+	}
+
+	name, formula, assign := strings.Cut(line, "=")
+	if !assign {
+		formula = line
+	}
+	o, err := language.EvaluateString(formula, session.Bindings)
+	if err != nil {
+		return "", err
+	}
+	if !assign {
+		return format(o), nil
+	}
+	key, err := refKey(name)
+	if err != nil {
+		return "", err
+	}
+	session.Bindings[key] = o
+	return key + " = " + format(o), nil
+}
+
+func (session *Session) command(line string) (string, error) {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case ":quit", ":q", ":exit":
+		return "", ErrQuit
+	case ":help", ":h":
+		return help, nil
+	case ":ops":
+		return strings.Join(sl.DefaultRegistry.Names(), "\n") + "\n", nil
+	case ":vars":
+		keys := make([]string, 0, len(session.Bindings))
+		for key := range session.Bindings {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var sb strings.Builder
+		for _, key := range keys {
+			sb.WriteString(key + " = " + format(session.Bindings[key]))
+		}
+		return sb.String(), nil
+	case ":del":
+		// the name may contain spaces, e.g. trust(A, B), and is normalised like the left-hand side of an assignment
+		name := strings.TrimSpace(strings.TrimPrefix(line, fields[0]))
+		if name == "" {
+			return "", errors.New("usage: :del name")
+		}
+		key := name
+		if _, ok := session.Bindings[key]; !ok {
+			if ref, err := refKey(name); err == nil {
+				key = ref
+			}
+		}
+		if _, ok := session.Bindings[key]; !ok {
+			return "", fmt.Errorf("unbound opinion %q", name)
+		}
+		delete(session.Bindings, key)
+		return "", nil
+	case ":evidence":
+		if len(fields) != 5 {
+			return "", errors.New("usage: :evidence name r s a")
+		}
+		key, err := refKey(fields[1])
+		if err != nil {
+			return "", err
+		}
+		var values [3]float64
+		for i := range values {
+			if values[i], err = strconv.ParseFloat(fields[i+2], 64); err != nil {
+				return "", fmt.Errorf("invalid number %q", fields[i+2])
+			}
+		}
+		o, err := sl.NewOpinionFromEvidence(values[0], values[1], values[2])
+		if err != nil {
+			return "", err
+		}
+		session.Bindings[key] = o
+		return key + " = " + format(o), nil
+	case ":save", ":load":
+		if len(fields) != 2 {
+			return "", fmt.Errorf("usage: %s file", fields[0])
+		}
+		if fields[0] == ":save" {
+			return "", session.SaveFile(fields[1])
+		}
+		return "", session.LoadFile(fields[1])
+	}
+	return "", fmt.Errorf("unknown command %s, enter :help for a list of commands", fields[0])
+}
+
+/*
+Save writes all bound opinions to w as a JSON object mapping names to opinions.
+*/
+func (session *Session) Save(w io.Writer) error {
+	opinions := make(map[string]*sl.Opinion, len(session.Bindings))
+	for key, o := range session.Bindings {
+		opinions[key] = &o
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(opinions)
+}
+
+/*
+Load reads a JSON object mapping names to opinions from r and binds them, replacing existing opinions of the same name.
+If the input is invalid, the session is left unchanged and an error is returned.
+*/
+func (session *Session) Load(r io.Reader) error {
+	var bindings expression.Bindings
+	if err := json.NewDecoder(r).Decode(&bindings); err != nil {
+		return err
+	}
+	for key, o := range bindings {
+		session.Bindings[key] = o
+	}
+	return nil
+}
+
+/*
+SaveFile saves all bound opinions to the file with the given name.
+*/
+func (session *Session) SaveFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := session.Save(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+/*
+LoadFile loads bound opinions from the file with the given name.
+*/
+func (session *Session) LoadFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return session.Load(f)
+}
+
+/*
+refKey returns the key of the reference the left-hand side of an assignment names.
+*/
+func refKey(name string) (string, error) {
+	n, err := language.Parse(name)
+	if err != nil {
+		return "", err
+	}
+	ref, ok := n.(expression.Ref)
+	if !ok {
+		return "", fmt.Errorf("cannot assign to %s", n)
+	}
+	return ref.Key(), nil
+}
+
+func format(o sl.Opinion) string {
+	return fmt.Sprintf("(%s)  P = %s\n", o.String(), strconv.FormatFloat(o.ProjectedProbability(), 'g', 12, 64))
+}
@@ -0,0 +1,114 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package repl
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestSession_Execute(t *testing.T) {
+	session := NewSession()
+	tests := []struct {
+		name    string
+		line    string
+		want    string
+		wantErr bool
+	}{
+		{"TestSession_Execute1", "", "", false},
+		{"TestSession_Execute2", "ωA = (0.6, 0.3, 0.1, 0)", "ωA = (0.6, 0.3, 0.1, 0)  P = 0.6\n", false},
+		{"TestSession_Execute3", ":evidence ωB 2 0 0.5", "ωB = (0.5, 0, 0.5, 0.5)  P = 0.75\n", false},
+		{"TestSession_Execute4", "ω1 = fuse_cum(ωA, ωB)", "ω1 = (0.6363636363636364, 0.2727272727272727, 0.09090909090909093, 0.05)  P = 0.640909090909\n", false},
+		{"TestSession_Execute5", "¬ωA", "(0.3, 0.6, 0.1, 1)  P = 0.4\n", false},
+		{"TestSession_Execute6", "trust(A,B) = ωA", "trust(A,B) = (0.6, 0.3, 0.1, 0)  P = 0.6\n", false},
+		{"TestSession_Execute7", ":vars", "trust(A,B) = (0.6, 0.3, 0.1, 0)  P = 0.6\n" +
+			"ω1 = (0.6363636363636364, 0.2727272727272727, 0.09090909090909093, 0.05)  P = 0.640909090909\nωA = (0.6, 0.3, 0.1, 0)  P = 0.6\nωB = (0.5, 0, 0.5, 0.5)  P = 0.75\n", false},
+		{"TestSession_Execute8", ":del trust(A,B)", "", false},
+
+		//errors
+		{"TestSession_Execute9", "ωC", "", true},
+		{"TestSession_Execute10", "ωA ⊕ = ωB", "", true},
+		{"TestSession_Execute11", "¬ωA = ωB", "", true},
+		{"TestSession_Execute12", ":del trust(A,B)", "", true},
+		{"TestSession_Execute13", ":evidence ωC -1 0 0.5", "", true},
+		{"TestSession_Execute14", ":unknown", "", true},
+		{"TestSession_Execute15", ":del", "", true},
+
+		// names with spaces
+		{"TestSession_Execute16", "trust(A, C) = ωA", "trust(A,C) = (0.6, 0.3, 0.1, 0)  P = 0.6\n", false},
+		{"TestSession_Execute17", ":del  trust(A, C) ", "", false},
+		{"TestSession_Execute18", ":del trust(A,C)", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := session.Execute(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Execute() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := session.Execute(":quit"); !errors.Is(err, ErrQuit) {
+		t.Errorf("Execute() error = %v, want %v", err, ErrQuit)
+	}
+}
+
+func TestSession_SaveLoad(t *testing.T) {
+	session := NewSession()
+	if _, err := session.Execute("x = (0.6, 0.3, 0.1, 0)"); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(t.TempDir(), "session.json")
+	if _, err := session.Execute(":save " + name); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := NewSession()
+	if _, err := loaded.Execute(":load " + name); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := sl.NewOpinion(0.6, 0.3, 0.1, 0)
+	if got, ok := loaded.Bindings["x"]; !ok || !got.Compare(want) {
+		t.Errorf("Load() got = %v, want %v", &got, &want)
+	}
+
+	if err := loaded.Load(strings.NewReader(`{"y": {"belief": 1, "disbelief": 1, "uncertainty": 0, "base_rate": 0}}`)); err == nil {
+		t.Errorf("Load() of an invalid opinion passed undetected")
+	}
+	if _, ok := loaded.Bindings["y"]; ok {
+		t.Errorf("Load() of an invalid opinion changed the session")
+	}
+}
+
+func TestSession_Run(t *testing.T) {
+	var out bytes.Buffer
+	in := strings.NewReader("x = (1, 0, 0, 0.5)\ny\n:quit\nx\n")
+	if err := NewSession().Run(in, &out); err != nil {
+		t.Fatal(err)
+	}
+	want := Prompt + "x = (1, 0, 0, 0.5)  P = 1\n" + Prompt + "error: Evaluate: unbound opinion \"y\"\n" + Prompt
+	if out.String() != want {
+		t.Errorf("Run() output = %q, want %q", out.String(), want)
+	}
+}
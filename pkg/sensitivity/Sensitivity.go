@@ -0,0 +1,196 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package sensitivity computes how strongly the result of an operator or of a whole computation depends on each of its input opinions.
+The partial derivatives are computed numerically by central differences of the formulas of the operators, i.e. the belief, disbelief,
+uncertainty and base rate of an input are varied independently of each other, as if they were unconstrained.
+*/
+package sensitivity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+const (
+	// step is the difference by which a value of an input is varied.
+	step = 1e-6
+	// tolerance is the tolerance of the Evaluator that accepts the varied inputs, whose values no longer sum up to 1 exactly.
+	tolerance = 1e-4
+)
+
+/*
+Partials holds the partial derivatives of a value with respect to the belief, disbelief, uncertainty and base rate of an input.
+*/
+type Partials struct {
+	Belief      float64 `json:"belief"`
+	Disbelief   float64 `json:"disbelief"`
+	Uncertainty float64 `json:"uncertainty"`
+	BaseRate    float64 `json:"base_rate"`
+}
+
+/*
+Norm is called onto Partials p and returns their Euclidean norm.
+*/
+func (p Partials) Norm() float64 {
+	return math.Sqrt(p.Belief*p.Belief + p.Disbelief*p.Disbelief + p.Uncertainty*p.Uncertainty + p.BaseRate*p.BaseRate)
+}
+
+/*
+Jacobian holds the partial derivatives of every component of a result, including its projected probability, with respect to one input.
+*/
+type Jacobian struct {
+	Belief               Partials `json:"belief"`
+	Disbelief            Partials `json:"disbelief"`
+	Uncertainty          Partials `json:"uncertainty"`
+	BaseRate             Partials `json:"base_rate"`
+	ProjectedProbability Partials `json:"projected_probability"`
+}
+
+/*
+Computation is a function of any number of input opinions, e.g. a single operator or a chain of operators.
+The Evaluator passed to it must be used for all operators, as it accepts the slightly invalid opinions the computation is varied with.
+*/
+type Computation func(e sl.Evaluator, opinions []sl.Opinion) (sl.Opinion, error)
+
+/*
+Unary returns the Computation of a unary operator, e.g. sl.Evaluator.Complement.
+*/
+func Unary(op func(sl.Evaluator, *sl.Opinion) (sl.Opinion, error)) Computation {
+	return func(e sl.Evaluator, opinions []sl.Opinion) (sl.Opinion, error) {
+		if len(opinions) != 1 {
+			return sl.Opinion{}, fmt.Errorf("Unary: expects 1 opinion, got %d", len(opinions))
+		}
+		return op(e, &opinions[0])
+	}
+}
+
+/*
+Binary returns the Computation of a binary operator, e.g. sl.Evaluator.CumulativeFusion.
+*/
+func Binary(op func(sl.Evaluator, *sl.Opinion, *sl.Opinion) (sl.Opinion, error)) Computation {
+	return func(e sl.Evaluator, opinions []sl.Opinion) (sl.Opinion, error) {
+		if len(opinions) != 2 {
+			return sl.Opinion{}, fmt.Errorf("Binary: expects 2 opinions, got %d", len(opinions))
+		}
+		return op(e, &opinions[0], &opinions[1])
+	}
+}
+
+/*
+Derivatives returns the Jacobian of the result of f with respect to each of the input opinions.
+Values on the bounds of [0, 1] are varied in one direction only. Operators that distinguish special cases, e.g. an uncertainty of 0,
+are differentiated within the case the inputs fall into, if possible.
+An error is returned if f fails on the inputs or on all variations of a value.
+*/
+func Derivatives(f Computation, opinions ...sl.Opinion) ([]Jacobian, error) {
+	if f == nil {
+		return nil, errors.New("Derivatives: Input cannot be nil")
+	}
+	e := sl.Evaluator{Tolerance: tolerance}
+	if _, err := f(e, opinions); err != nil {
+		return nil, fmt.Errorf("Derivatives: %w", err)
+	}
+
+	jacobians := make([]Jacobian, len(opinions))
+	varied := make([]sl.Opinion, len(opinions))
+	copy(varied, opinions)
+	for i, o := range opinions {
+		values := [4]float64{o.Belief(), o.Disbelief(), o.Uncertainty(), o.BaseRate()}
+		for j := range values {
+			// evaluates f with the j-th value of the i-th input shifted by delta
+			at := func(delta float64) (sl.Opinion, bool) {
+				v := values
+				v[j] += delta
+				var err error
+				if varied[i], err = e.NewOpinion(v[0], v[1], v[2], v[3]); err != nil {
+					return sl.Opinion{}, false
+				}
+				r, err := f(e, varied)
+				return r, err == nil
+			}
+			low, okLow := at(-step)
+			high, okHigh := at(step)
+			width := 2 * step
+			if !okLow || !okHigh {
+				mid, _ := at(0)
+				switch {
+				case okHigh:
+					low, width = mid, step
+				case okLow:
+					high, width = mid, step
+				default:
+					return nil, fmt.Errorf("Derivatives: cannot vary input %d", i+1)
+				}
+			}
+			jacobians[i].Belief.set(j, (high.Belief()-low.Belief())/width)
+			jacobians[i].Disbelief.set(j, (high.Disbelief()-low.Disbelief())/width)
+			jacobians[i].Uncertainty.set(j, (high.Uncertainty()-low.Uncertainty())/width)
+			jacobians[i].BaseRate.set(j, (high.BaseRate()-low.BaseRate())/width)
+			jacobians[i].ProjectedProbability.set(j, (high.ProjectedProbability()-low.ProjectedProbability())/width)
+		}
+		varied[i] = o
+	}
+	return jacobians, nil
+}
+
+/*
+set is called onto *Partials p and sets the derivative with respect to the value with the given index,
+in the order belief, disbelief, uncertainty, base rate.
+*/
+func (p *Partials) set(index int, v float64) {
+	switch index {
+	case 0:
+		p.Belief = v
+	case 1:
+		p.Disbelief = v
+	case 2:
+		p.Uncertainty = v
+	case 3:
+		p.BaseRate = v
+	}
+}
+
+/*
+Influence describes how strongly the projected probability of the result of a computation depends on the input with the given Index.
+*/
+type Influence struct {
+	Index     int      `json:"index"`
+	Partials  Partials `json:"partials"`
+	Magnitude float64  `json:"magnitude"`
+}
+
+/*
+Rank returns the Influence of every input on the projected probability of the result of f, ordered from the most to the least influential input.
+The Magnitude of an Influence is the Euclidean norm of its Partials; inputs with the same Magnitude keep their order.
+*/
+func Rank(f Computation, opinions ...sl.Opinion) ([]Influence, error) {
+	jacobians, err := Derivatives(f, opinions...)
+	if err != nil {
+		return nil, err
+	}
+	influences := make([]Influence, len(jacobians))
+	for i, j := range jacobians {
+		influences[i] = Influence{Index: i, Partials: j.ProjectedProbability, Magnitude: j.ProjectedProbability.Norm()}
+	}
+	sort.SliceStable(influences, func(i, j int) bool {
+		return influences[i].Magnitude > influences[j].Magnitude
+	})
+	return influences, nil
+}
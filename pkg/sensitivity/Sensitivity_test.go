@@ -0,0 +1,179 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package sensitivity
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func closePartials(p1, p2 Partials) bool {
+	return math.Abs(p1.Belief-p2.Belief) < 1e-6 && math.Abs(p1.Disbelief-p2.Disbelief) < 1e-6 &&
+		math.Abs(p1.Uncertainty-p2.Uncertainty) < 1e-6 && math.Abs(p1.BaseRate-p2.BaseRate) < 1e-6
+}
+
+func TestDerivatives(t *testing.T) {
+	var x, y, dogmatic sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&x, 0.2, 0.3, 0.5, 0.4},
+		{&y, 0.6, 0.1, 0.3, 0.5},
+		{&dogmatic, 1, 0, 0, 0},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	// denominator of the cumulative fusion of x and y
+	k := 0.5 + 0.3 - 0.5*0.3
+
+	tests := []struct {
+		name     string
+		f        Computation
+		opinions []sl.Opinion
+		input    int
+		row      func(Jacobian) Partials
+		want     Partials
+	}{
+		{
+			name:     "Complement belief",
+			f:        Unary(sl.Evaluator.Complement),
+			opinions: []sl.Opinion{x},
+			row:      func(j Jacobian) Partials { return j.Belief },
+			want:     Partials{Disbelief: 1},
+		},
+		{
+			name:     "Complement projected probability",
+			f:        Unary(sl.Evaluator.Complement),
+			opinions: []sl.Opinion{x},
+			row:      func(j Jacobian) Partials { return j.ProjectedProbability },
+			want:     Partials{Disbelief: 1, Uncertainty: 0.6, BaseRate: -0.5},
+		},
+		{
+			name:     "Complement of a dogmatic opinion",
+			f:        Unary(sl.Evaluator.Complement),
+			opinions: []sl.Opinion{dogmatic},
+			row:      func(j Jacobian) Partials { return j.ProjectedProbability },
+			want:     Partials{Disbelief: 1, Uncertainty: 1},
+		},
+		{
+			name:     "CumulativeFusion belief by first input",
+			f:        Binary(sl.Evaluator.CumulativeFusion),
+			opinions: []sl.Opinion{x, y},
+			row:      func(j Jacobian) Partials { return j.Belief },
+			want: Partials{
+				Belief:      0.3 / k,
+				Uncertainty: 0.6/k - (0.2*0.3+0.6*0.5)*(1-0.3)/(k*k),
+			},
+		},
+		{
+			name:     "CumulativeFusion uncertainty by second input",
+			f:        Binary(sl.Evaluator.CumulativeFusion),
+			opinions: []sl.Opinion{x, y},
+			input:    1,
+			row:      func(j Jacobian) Partials { return j.Uncertainty },
+			want:     Partials{Uncertainty: 0.5/k - 0.5*0.3*(1-0.5)/(k*k)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jacobians, err := Derivatives(tt.f, tt.opinions...)
+			if err != nil {
+				t.Fatalf("Derivatives() error = %v", err)
+			}
+			if len(jacobians) != len(tt.opinions) {
+				t.Fatalf("Derivatives() got %d jacobians, want %d", len(jacobians), len(tt.opinions))
+			}
+			if got := tt.row(jacobians[tt.input]); !closePartials(got, tt.want) {
+				t.Errorf("Derivatives() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDerivativesError(t *testing.T) {
+	var belief, disbelief sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&belief, 1, 0, 0, 0.5},
+		{&disbelief, 0, 1, 0, 0.5},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+	conflict := []sl.Opinion{belief, disbelief}
+	if _, err := Derivatives(Binary(sl.Evaluator.ConstraintFusion), conflict...); err == nil {
+		t.Errorf("Derivatives() of a failing operator does not fail")
+	}
+	if _, err := Derivatives(Binary(sl.Evaluator.CumulativeFusion), conflict[0]); err == nil {
+		t.Errorf("Derivatives() with a wrong number of inputs does not fail")
+	}
+	if _, err := Derivatives(nil); err == nil {
+		t.Errorf("Derivatives() of nil does not fail")
+	}
+}
+
+func TestRank(t *testing.T) {
+	// op(A,x) = trust(A,B) ⊗ op(B,x) ⊕ op(A,x), where the direct opinion of A is nearly vacuous
+	path := func(e sl.Evaluator, opinions []sl.Opinion) (sl.Opinion, error) {
+		discounted, err := e.TrustDiscounting(&opinions[0], &opinions[1])
+		if err != nil {
+			return sl.Opinion{}, err
+		}
+		return e.CumulativeFusion(&discounted, &opinions[2])
+	}
+	var opinions []sl.Opinion
+	for _, v := range [][4]float64{{0.8, 0.1, 0.1, 0.5}, {0.7, 0.2, 0.1, 0.5}, {0.05, 0.05, 0.9, 0.5}} {
+		o, err := sl.NewOpinion(v[0], v[1], v[2], v[3])
+		if err != nil {
+			t.Fatal(err)
+		}
+		opinions = append(opinions, o)
+	}
+
+	influences, err := Rank(path, opinions...)
+	if err != nil {
+		t.Fatalf("Rank() error = %v", err)
+	}
+	if len(influences) != 3 {
+		t.Fatalf("Rank() got %d influences, want 3", len(influences))
+	}
+	if !sort.SliceIsSorted(influences, func(i, j int) bool { return influences[i].Magnitude > influences[j].Magnitude }) {
+		t.Errorf("Rank() is not ordered by magnitude, got = %+v", influences)
+	}
+	// the trusted opinion of B dominates the result, as A's own opinion is nearly vacuous
+	if influences[0].Index != 1 {
+		t.Errorf("Rank() got input %d as most influential, want input 1", influences[0].Index)
+	}
+
+	jacobians, _ := Derivatives(path, opinions...)
+	for _, influence := range influences {
+		if influence.Partials != jacobians[influence.Index].ProjectedProbability {
+			t.Errorf("Rank() got partials %+v for input %d, want %+v", influence.Partials, influence.Index, jacobians[influence.Index].ProjectedProbability)
+		}
+	}
+}
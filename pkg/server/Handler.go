@@ -0,0 +1,239 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package server exposes the operators of the subjectivelogic package as a JSON web service.
+
+The handler returned by NewHandler serves the following endpoints:
+
+	GET  /operators          list the names of all operators of subjectivelogic.DefaultRegistry
+	POST /operators/{name}   apply an operator to {"opinions": [...]}
+	POST /evaluate           evaluate {"formula": "...", "bindings": {"name": {...}}}
+
+Opinions use the JSON format of the subjectivelogic package. Successful requests are answered with
+{"opinion": {...}, "projected_probability": p}, failed requests with {"error": {"code": ..., "message": ..., "operator": ...}}.
+*/
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vs-uulm/go-subjectivelogic/pkg/expression"
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+MaxBodySize is the maximum size of a request body in bytes.
+*/
+const MaxBodySize = 1 << 20
+
+/*
+Error codes reported in the code field of error responses.
+*/
+const (
+	CodeInvalidRequest  = "invalid_request"
+	CodeInvalidArity    = "invalid_arity"
+	CodeUnboundName     = "unbound_name"
+	CodeUnknownOperator = "unknown_operator"
+	CodeOperatorFailed  = "operator_failed"
+	CodeNullOpinion     = "null_opinion"
+	CodeInvalidOpinion  = "invalid_opinion"
+	CodeUndefined       = "undefined"
+	CodeTotalConflict   = "total_conflict"
+)
+
+/*
+operatorCodes maps the sentinel errors of the subjectivelogic package to the codes reported for them.
+*/
+var operatorCodes = []struct {
+	err  error
+	code string
+}{
+	{sl.ErrNullOpinion, CodeNullOpinion},
+	{sl.ErrInvalidOpinion, CodeInvalidOpinion},
+	{sl.ErrUndefined, CodeUndefined},
+	{sl.ErrTotalConflict, CodeTotalConflict},
+}
+
+/*
+language evaluates formulas with the operators of subjectivelogic.DefaultRegistry.
+*/
+var language = expression.Language{Registry: sl.DefaultRegistry}
+
+/*
+OperatorRequest is the body of a request to apply an operator.
+*/
+type OperatorRequest struct {
+	Opinions []sl.Opinion `json:"opinions"`
+}
+
+/*
+EvaluateRequest is the body of a request to evaluate a formula of the expression package.
+*/
+type EvaluateRequest struct {
+	Formula  string              `json:"formula"`
+	Bindings expression.Bindings `json:"bindings"`
+}
+
+/*
+Result is the body of a successful response.
+*/
+type Result struct {
+	Opinion              *sl.Opinion `json:"opinion"`
+	ProjectedProbability float64     `json:"projected_probability"`
+}
+
+/*
+Error describes why a request failed. If the request failed within an operator, Operator is the name of the failing
+function of the subjectivelogic package and Code tells which of its sentinel errors it failed with.
+*/
+type Error struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Operator string `json:"operator,omitempty"`
+}
+
+/*
+ErrorResponse is the body of a failed response.
+*/
+type ErrorResponse struct {
+	Error Error `json:"error"`
+}
+
+/*
+NewHandler returns an http.Handler serving the operators of subjectivelogic.DefaultRegistry.
+It can be mounted on any path of an existing server using http.StripPrefix.
+*/
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /operators", listOperators)
+	mux.HandleFunc("POST /operators/{name}", applyOperator)
+	mux.HandleFunc("POST /evaluate", evaluate)
+	return mux
+}
+
+func listOperators(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, struct {
+		Operators []string `json:"operators"`
+	}{sl.DefaultRegistry.Names()})
+}
+
+func applyOperator(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	arity, minArity, ok := sl.DefaultRegistry.Arity(name)
+	if !ok {
+		writeError(w, http.StatusNotFound, Error{Code: CodeUnknownOperator, Message: fmt.Sprintf("unknown operator %q", name)})
+		return
+	}
+	var req OperatorRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	// a wrong number of opinions is a malformed request, not a failure of the operator
+	switch n := len(req.Opinions); {
+	case arity >= 0 && n != arity:
+		writeError(w, http.StatusBadRequest, Error{Code: CodeInvalidArity, Message: fmt.Sprintf("%s expects %d opinions, got %d", name, arity, n)})
+		return
+	case arity < 0 && n < minArity:
+		writeError(w, http.StatusBadRequest, Error{Code: CodeInvalidArity, Message: fmt.Sprintf("%s expects at least %d opinions, got %d", name, minArity, n)})
+		return
+	}
+	o, err := sl.DefaultRegistry.Apply(name, req.Opinions...)
+	switch {
+	case errors.Is(err, sl.ErrUnknownOperator):
+		// the operator was unregistered since the lookup above
+		writeError(w, http.StatusNotFound, Error{Code: CodeUnknownOperator, Message: err.Error()})
+	case err != nil:
+		writeError(w, http.StatusUnprocessableEntity, operatorFailure(err))
+	default:
+		writeResult(w, o)
+	}
+}
+
+func evaluate(w http.ResponseWriter, r *http.Request) {
+	var req EvaluateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	n, err := language.Parse(req.Formula)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, Error{Code: CodeInvalidRequest, Message: err.Error()})
+		return
+	}
+	for _, key := range expression.Refs(n) {
+		if _, ok := req.Bindings[key]; !ok {
+			writeError(w, http.StatusBadRequest, Error{Code: CodeUnboundName, Message: fmt.Sprintf("unbound opinion %q", key)})
+			return
+		}
+	}
+	o, err := language.Evaluate(n, req.Bindings)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, operatorFailure(err))
+		return
+	}
+	writeResult(w, o)
+}
+
+/*
+operatorFailure describes the error err an operator failed with.
+*/
+func operatorFailure(err error) Error {
+	e := Error{Code: CodeOperatorFailed, Message: err.Error()}
+	var opErr *sl.OperatorError
+	if errors.As(err, &opErr) {
+		e.Operator = opErr.Op
+	}
+	for _, c := range operatorCodes {
+		if errors.Is(err, c.err) {
+			e.Code = c.code
+			break
+		}
+	}
+	return e
+}
+
+/*
+decode reads the JSON body of r into v. If the body is invalid, an error response is written and false is returned.
+*/
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeError(w, status, Error{Code: CodeInvalidRequest, Message: "invalid request body: " + err.Error()})
+		return false
+	}
+	return true
+}
+
+func writeResult(w http.ResponseWriter, o sl.Opinion) {
+	writeJSON(w, http.StatusOK, Result{Opinion: &o, ProjectedProbability: o.ProjectedProbability()})
+}
+
+func writeError(w http.ResponseWriter, status int, e Error) {
+	writeJSON(w, status, ErrorResponse{Error: e})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,122 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		want       sl.Opinion
+		wantCode   string
+		wantOp     string
+	}{
+		{"TestHandler1", http.MethodPost, "/operators/fuse_cum",
+			`{"opinions": [{"belief": 0.6, "disbelief": 0.3, "uncertainty": 0.1, "base_rate": 0}, {"belief": 0.091, "disbelief": 0.604, "uncertainty": 0.305, "base_rate": 0.4}]}`,
+			http.StatusOK, sl.Opinion{}, "", ""},
+		{"TestHandler2", http.MethodPost, "/operators/disc",
+			`{"opinions": [{"belief": 0.6, "disbelief": 0.3, "uncertainty": 0.1, "base_rate": 0}, {"belief": 0.091, "disbelief": 0.604, "uncertainty": 0.305, "base_rate": 0.4}]}`,
+			http.StatusOK, sl.Opinion{}, "", ""},
+		{"TestHandler3", http.MethodPost, "/evaluate",
+			`{"formula": "¬x", "bindings": {"x": {"belief": 0.6, "disbelief": 0.3, "uncertainty": 0.1, "base_rate": 0}}}`,
+			http.StatusOK, sl.Opinion{}, "", ""},
+
+		//errors
+		{"TestHandler4", http.MethodPost, "/operators/unknown", `{"opinions": []}`, http.StatusNotFound, sl.Opinion{}, CodeUnknownOperator, ""},
+		{"TestHandler5", http.MethodPost, "/operators/add", `{"opinions": [`, http.StatusBadRequest, sl.Opinion{}, CodeInvalidRequest, ""},
+		{"TestHandler6", http.MethodPost, "/operators/add", `{"opinion": []}`, http.StatusBadRequest, sl.Opinion{}, CodeInvalidRequest, ""},
+		{"TestHandler7", http.MethodPost, "/operators/add",
+			`{"opinions": [{"belief": 1, "disbelief": 1, "uncertainty": 0, "base_rate": 0}]}`, http.StatusBadRequest, sl.Opinion{}, CodeInvalidRequest, ""},
+		{"TestHandler8", http.MethodPost, "/operators/add",
+			`{"opinions": [{"belief": 1, "disbelief": 0, "uncertainty": 0, "base_rate": 0.5}, {"belief": 1, "disbelief": 0, "uncertainty": 0, "base_rate": 0.5}]}`,
+			http.StatusUnprocessableEntity, sl.Opinion{}, CodeInvalidOpinion, "Addition"},
+		{"TestHandler9", http.MethodPost, "/operators/not", `{"opinions": []}`, http.StatusBadRequest, sl.Opinion{}, CodeInvalidArity, ""},
+		{"TestHandler10", http.MethodPost, "/evaluate", `{"formula": "x ⊕", "bindings": {}}`, http.StatusBadRequest, sl.Opinion{}, CodeInvalidRequest, ""},
+		{"TestHandler11", http.MethodPost, "/evaluate", `{"formula": "x ⊕ y", "bindings": {}}`, http.StatusBadRequest, sl.Opinion{}, CodeUnboundName, ""},
+		{"TestHandler12", http.MethodGet, "/operators/add", "", http.StatusMethodNotAllowed, sl.Opinion{}, "", ""},
+		{"TestHandler13", http.MethodPost, "/evaluate",
+			`{"formula": "fuse_con(x, y)", "bindings": {"x": {"belief": 1, "disbelief": 0, "uncertainty": 0, "base_rate": 0.5}, "y": {"belief": 0, "disbelief": 1, "uncertainty": 0, "base_rate": 0.5}}}`,
+			http.StatusUnprocessableEntity, sl.Opinion{}, CodeTotalConflict, "ConstraintFusion"},
+		{"TestHandler14", http.MethodPost, "/operators/disc_multi",
+			`{"opinions": [{"belief": 0.6, "disbelief": 0.3, "uncertainty": 0.1, "base_rate": 0}]}`, http.StatusBadRequest, sl.Opinion{}, CodeInvalidArity, ""},
+		{"TestHandler15", http.MethodPost, "/operators/add", `{"opinions": [{"belief": 0.6, "disbelief": 0.3, "uncertainty": 0.1, "base_rate": 0}]}`,
+			http.StatusBadRequest, sl.Opinion{}, CodeInvalidArity, ""},
+		{"TestHandler16", http.MethodPost, "/evaluate",
+			`{"formula": "trust(A,B) ⊗ op(B,x)", "bindings": {"trust(A,B)": {"belief": 0.6, "disbelief": 0.3, "uncertainty": 0.1, "base_rate": 0}}}`,
+			http.StatusBadRequest, sl.Opinion{}, CodeUnboundName, ""},
+	}
+
+	x, _ := sl.NewOpinion(0.6, 0.3, 0.1, 0)
+	y, _ := sl.NewOpinion(0.091, 0.604, 0.305, 0.4)
+	tests[0].want, _ = sl.CumulativeFusion(&x, &y)
+	tests[1].want, _ = sl.TrustDiscounting(&x, &y)
+	tests[2].want, _ = sl.Complement(&x)
+
+	handler := NewHandler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("ServeHTTP() status = %d, want %d | body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK {
+				var res Result
+				if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+					t.Fatal(err)
+				}
+				if !res.Opinion.Compare(tt.want) || res.ProjectedProbability != tt.want.ProjectedProbability() {
+					t.Errorf("ServeHTTP() got = %v, want %v", res.Opinion, &tt.want)
+				}
+			}
+			if tt.wantCode != "" {
+				var res ErrorResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+					t.Fatal(err)
+				}
+				if res.Error.Code != tt.wantCode || res.Error.Operator != tt.wantOp || res.Error.Message == "" {
+					t.Errorf("ServeHTTP() error = %+v, want code %s and operator %q", res.Error, tt.wantCode, tt.wantOp)
+				}
+			}
+		})
+	}
+}
+
+func TestHandler_Operators(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/operators", nil))
+	var res struct {
+		Operators []string `json:"operators"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(res.Operators, "fuse_cum") || !slices.Contains(res.Operators, "disc_multi") {
+		t.Errorf("ServeHTTP() got = %v", res.Operators)
+	}
+}
@@ -0,0 +1,222 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package store provides a concurrency-safe store of opinions, e.g. about entities that are updated from many goroutines.
+*/
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Change describes a modification of a Store. Old is the zero Opinion if the key was created, New is the zero Opinion if it was deleted.
+*/
+type Change struct {
+	Key     string
+	Old     sl.Opinion
+	New     sl.Opinion
+	Created bool
+	Deleted bool
+}
+
+/*
+Store is a map of keys to opinions that is safe for concurrent use.
+The zero value is not usable, stores are created with NewStore.
+*/
+type Store struct {
+	mu          sync.RWMutex
+	opinions    map[string]sl.Opinion
+	subscribers map[*subscriber]bool
+}
+
+/*
+NewStore returns an empty *Store.
+*/
+func NewStore() *Store {
+	return &Store{opinions: map[string]sl.Opinion{}, subscribers: map[*subscriber]bool{}}
+}
+
+/*
+Get is called onto a *Store s and returns the opinion stored for key, if any.
+*/
+func (s *Store) Get(key string) (sl.Opinion, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	o, ok := s.opinions[key]
+	return o, ok
+}
+
+/*
+Set is called onto a *Store s and stores the opinion o for key, replacing any previous one.
+*/
+func (s *Store) Set(key string, o sl.Opinion) {
+	s.Update(key, func(sl.Opinion, bool) (sl.Opinion, error) {
+		return o, nil
+	})
+}
+
+/*
+Delete is called onto a *Store s and removes the opinion stored for key. It returns false, if there was none.
+*/
+func (s *Store) Delete(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.opinions[key]
+	if ok {
+		delete(s.opinions, key)
+		s.notify(Change{Key: key, Old: old, Deleted: true})
+	}
+	return ok
+}
+
+/*
+Update is called onto a *Store s and atomically replaces the opinion stored for key with the result of f,
+which receives the stored opinion and whether there was one. No other modification of s happens between reading and writing.
+If f returns an error, s is left unchanged and the error is returned. f must not call methods of s.
+*/
+func (s *Store) Update(key string, f func(old sl.Opinion, ok bool) (sl.Opinion, error)) (sl.Opinion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.opinions[key]
+	o, err := f(old, ok)
+	if err != nil {
+		return sl.Opinion{}, err
+	}
+	s.opinions[key] = o
+	s.notify(Change{Key: key, Old: old, New: o, Created: !ok})
+	return o, nil
+}
+
+/*
+Apply is called onto a *Store s and atomically replaces the opinion stored for key with op(stored, o),
+e.g. s.Apply("entity", subjectivelogic.CumulativeFusion, observation) fuses a new observation into the stored opinion.
+If there is no opinion stored for key, o is stored. If op fails, s is left unchanged and the error is returned.
+*/
+func (s *Store) Apply(key string, op func(*sl.Opinion, *sl.Opinion) (sl.Opinion, error), o sl.Opinion) (sl.Opinion, error) {
+	return s.Update(key, func(old sl.Opinion, ok bool) (sl.Opinion, error) {
+		if !ok {
+			return o, nil
+		}
+		return op(&old, &o)
+	})
+}
+
+/*
+Len is called onto a *Store s and returns the number of stored opinions.
+*/
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.opinions)
+}
+
+/*
+Keys is called onto a *Store s and returns all keys of s, sorted by name.
+*/
+func (s *Store) Keys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]string, 0, len(s.opinions))
+	for key := range s.opinions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+/*
+Snapshot is called onto a *Store s and returns a copy of all stored opinions at a single point in time.
+*/
+func (s *Store) Snapshot() map[string]sl.Opinion {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snapshot := make(map[string]sl.Opinion, len(s.opinions))
+	for key, o := range s.opinions {
+		snapshot[key] = o
+	}
+	return snapshot
+}
+
+/*
+Subscribe is called onto a *Store s and returns a channel that receives every Change of s from now on, in the order of the changes.
+Changes are buffered for slow receivers, so they never block modifications of s.
+When ctx is done, the subscription ends and the channel is closed.
+*/
+func (s *Store) Subscribe(ctx context.Context) <-chan Change {
+	sub := &subscriber{signal: make(chan struct{}, 1)}
+	out := make(chan Change)
+
+	s.mu.Lock()
+	s.subscribers[sub] = true
+	s.mu.Unlock()
+
+	go func() {
+		defer close(out)
+		defer func() {
+			s.mu.Lock()
+			delete(s.subscribers, sub)
+			s.mu.Unlock()
+		}()
+		for {
+			select {
+			case <-sub.signal:
+			case <-ctx.Done():
+				return
+			}
+			for _, change := range sub.take() {
+				select {
+				case out <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+/*
+notify queues change for all subscribers. The caller must hold the write lock of s.
+*/
+func (s *Store) notify(change Change) {
+	for sub := range s.subscribers {
+		sub.mu.Lock()
+		sub.queue = append(sub.queue, change)
+		sub.mu.Unlock()
+		select {
+		case sub.signal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+type subscriber struct {
+	mu     sync.Mutex
+	queue  []Change
+	signal chan struct{}
+}
+
+func (sub *subscriber) take() []Change {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	queue := sub.queue
+	sub.queue = nil
+	return queue
+}
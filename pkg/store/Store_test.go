@@ -0,0 +1,154 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestStore(t *testing.T) {
+	var x, y, belief, conflict sl.Opinion
+	for _, tt := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&x, 0.6, 0.3, 0.1, 0.5},
+		{&y, 0.2, 0.2, 0.6, 0.5},
+		{&belief, 1, 0, 0, 0.5},
+		{&conflict, 0, 1, 0, 0.5},
+	} {
+		o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*tt.opinion = o
+	}
+
+	s := NewStore()
+	if _, ok := s.Get("a"); ok {
+		t.Errorf("Get() found a key of an empty store")
+	}
+	got, err := s.Apply("a", sl.CumulativeFusion, x)
+	if err != nil || got != x {
+		t.Errorf("Apply() on a missing key got = %v, %v, want %v", &got, err, &x)
+	}
+	want, _ := sl.CumulativeFusion(&x, &y)
+	got, err = s.Apply("a", sl.CumulativeFusion, y)
+	if err != nil || got != want {
+		t.Errorf("Apply() got = %v, %v, want %v", &got, err, &want)
+	}
+	if got, _ := s.Get("a"); got != want {
+		t.Errorf("Get() got = %v, want %v", &got, &want)
+	}
+
+	// a failing operator leaves the store unchanged
+	s.Set("b", belief)
+	if _, err := s.Apply("b", sl.ConstraintFusion, conflict); !errors.Is(err, sl.ErrTotalConflict) {
+		t.Errorf("Apply() error = %v, want %v", err, sl.ErrTotalConflict)
+	}
+	if got, _ := s.Get("b"); got != belief {
+		t.Errorf("Apply() modified the store on error, got = %v", &got)
+	}
+
+	snapshot := s.Snapshot()
+	s.Set("c", x)
+	if !reflect.DeepEqual(snapshot, map[string]sl.Opinion{"a": want, "b": belief}) {
+		t.Errorf("Snapshot() got = %v", snapshot)
+	}
+	if keys := s.Keys(); !reflect.DeepEqual(keys, []string{"a", "b", "c"}) || s.Len() != 3 {
+		t.Errorf("Keys() got = %v, Len() = %d", keys, s.Len())
+	}
+	if !s.Delete("c") || s.Delete("c") {
+		t.Errorf("Delete() did not report the existence of the key")
+	}
+}
+
+func TestStore_Concurrent(t *testing.T) {
+	disbelief, err := sl.NewOpinion(0, 1, 0, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewStore()
+	s.Set("a", disbelief)
+
+	const n = 500
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// moves 0.001 from disbelief to belief, so lost updates show in the result
+			_, err := s.Update("a", func(old sl.Opinion, _ bool) (sl.Opinion, error) {
+				return sl.NewOpinion(old.Belief()+0.001, old.Disbelief()-0.001, 0, 0.5)
+			})
+			if err != nil {
+				t.Error(err)
+			}
+			s.Snapshot()
+		}()
+	}
+	wg.Wait()
+
+	got, _ := s.Get("a")
+	if b := got.Belief(); b < 0.5-1e-9 || b > 0.5+1e-9 {
+		t.Errorf("Update() lost updates, belief = %v, want 0.5", b)
+	}
+}
+
+func TestStore_Subscribe(t *testing.T) {
+	x, err := sl.NewOpinion(0.6, 0.3, 0.1, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := sl.NewOpinion(0.2, 0.2, 0.6, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := s.Subscribe(ctx)
+
+	s.Set("a", x)
+	s.Set("a", y)
+	s.Delete("a")
+
+	want := []Change{
+		{Key: "a", New: x, Created: true},
+		{Key: "a", Old: x, New: y},
+		{Key: "a", Old: y, Deleted: true},
+	}
+	for _, w := range want {
+		if got := <-changes; got != w {
+			t.Errorf("Subscribe() got = %+v, want %+v", got, w)
+		}
+	}
+
+	cancel()
+	for range changes {
+	}
+	s.Set("b", x)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.subscribers) != 0 {
+		t.Errorf("Subscribe() did not remove the subscriber after cancellation")
+	}
+}
@@ -0,0 +1,109 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+)
+
+/*
+NewOpinionFromProbability takes a probability p observed in a sample of size n, e.g. the calibrated score of a classifier
+and the size of its calibration set, as well as a base rate, and outputs the corresponding Opinion as well as an Error.
+The sample is taken as r = p*n positive and s = (1-p)*n negative observations, i.e. the Opinion equals NewOpinionFromEvidence(p*n, (1-p)*n, baseRate).
+If p violates 0 <= p <= 1, n is negative or not finite, or the base rate violates 0 <= a <= 1, a zeroed Opinion and an error are returned.
+*/
+func NewOpinionFromProbability(p, n, baseRate float64) (Opinion, error) {
+	return NewOpinionFromProbabilityOf(p, n, baseRate)
+}
+
+/*
+NewOpinionFromProbabilityOf is the generic counterpart of NewOpinionFromProbability.
+*/
+func NewOpinionFromProbabilityOf[T Float](p, n, baseRate T) (OpinionOf[T], error) {
+	if !(0 <= p && p <= 1) {
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromProbability", ErrInvalidOpinion, "Probability must lie in [0, 1]")
+	}
+	if !(n >= 0) || math.IsInf(float64(n), 1) {
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromProbability", ErrInvalidOpinion, "Sample size must be non-negative and finite")
+	}
+	w := T(PriorWeight)
+	sum := n + w
+	return newResult(Evaluator{}, "NewOpinionFromProbability", p*n/sum, (1-p)*n/sum, w/sum, baseRate)
+}
+
+/*
+NewOpinionFromInterval takes the bounds of an interval [lo, hi] that contains a probability and a base rate,
+and outputs the corresponding Opinion as well as an Error.
+The bounds are taken as the lower and upper probability, i.e. as belief b = lo and plausibility b + u = hi,
+so the Opinion is formed as b = lo, d = 1-hi, u = hi-lo. Its projected probability lo + a*(hi-lo) lies in the interval.
+If the bounds violate 0 <= lo <= hi <= 1 or the base rate violates 0 <= a <= 1, a zeroed Opinion and an error are returned.
+*/
+func NewOpinionFromInterval(lo, hi, baseRate float64) (Opinion, error) {
+	return NewOpinionFromIntervalOf(lo, hi, baseRate)
+}
+
+/*
+NewOpinionFromIntervalOf is the generic counterpart of NewOpinionFromInterval.
+*/
+func NewOpinionFromIntervalOf[T Float](lo, hi, baseRate T) (OpinionOf[T], error) {
+	if !(0 <= lo && lo <= hi && hi <= 1) {
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromInterval", ErrInvalidOpinion, "Bounds must satisfy 0 <= lo <= hi <= 1")
+	}
+	return newResult(Evaluator{}, "NewOpinionFromInterval", lo, 1-hi, hi-lo, baseRate)
+}
+
+/*
+NewOpinionFromMoments takes the mean and variance of a probability and a base rate, and outputs the Opinion whose Beta distribution
+has the same mean and variance, as well as an Error.
+The mean m and the variance v determine the Beta parameters alpha + beta = m*(1-m)/v - 1, from which the Opinion is formed
+like in NewOpinionFromEvidence. A variance of 0 forms a dogmatic Opinion, and the variance a*(1-a)/(W+1) of the uniform prior
+with the mean a forms the vacuous Opinion.
+If the mean violates 0 <= m <= 1, the variance is negative, or the variance is too large to be represented with the given base rate,
+a zeroed Opinion and an error are returned.
+*/
+func NewOpinionFromMoments(mean, variance, baseRate float64) (Opinion, error) {
+	return NewOpinionFromMomentsOf(mean, variance, baseRate)
+}
+
+/*
+NewOpinionFromMomentsOf is the generic counterpart of NewOpinionFromMoments.
+*/
+func NewOpinionFromMomentsOf[T Float](mean, variance, baseRate T) (OpinionOf[T], error) {
+	if !(0 <= mean && mean <= 1) || !(variance >= 0) {
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromMoments", ErrInvalidOpinion, "Mean must lie in [0, 1] and variance must be non-negative")
+	}
+	return fromMoments("NewOpinionFromMoments", float64(mean), float64(variance), baseRate)
+}
+
+/*
+fromMoments forms the Opinion of the function op whose Beta distribution has the given mean and variance.
+*/
+func fromMoments[T Float](op string, mean, variance float64, baseRate T) (OpinionOf[T], error) {
+	if variance == 0 {
+		return newResult(Evaluator{}, op, T(mean), T(1-mean), 0, baseRate)
+	}
+	// alpha + beta = r + s + W, where r = s = 0 forms the vacuous opinion
+	sum := mean*(1-mean)/variance - 1
+	if !(sum >= PriorWeight) {
+		return OpinionOf[T]{}, newOperatorError[T](op, ErrInvalidOpinion, "Variance is too large for the mean")
+	}
+	a := float64(baseRate)
+	b := (mean*sum - PriorWeight*a) / sum
+	d := ((1-mean)*sum - PriorWeight*(1-a)) / sum
+	if b < 0 || d < 0 {
+		return OpinionOf[T]{}, newOperatorError[T](op, ErrInvalidOpinion, "Variance is too large for the base rate")
+	}
+	return newResult(Evaluator{}, op, T(b), T(d), T(PriorWeight/sum), baseRate)
+}
@@ -0,0 +1,154 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewOpinionFromProbability(t *testing.T) {
+	type args struct {
+		p        float64
+		n        float64
+		baseRate float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Opinion
+		wantErr bool
+	}{
+		//invalid input
+		{"TestNewOpinionFromProbability1", args{-0.1, 10, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromProbability2", args{1.1, 10, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromProbability3", args{0.5, -1, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromProbability4", args{0.5, math.Inf(1), 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromProbability5", args{math.NaN(), 10, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromProbability6", args{0.5, 10, 1.5}, Opinion{}, true},
+
+		//general tests
+		{"TestNewOpinionFromProbability7", args{0.5, 0, 0.3}, Opinion{0, 0, 1, 0.3}, false},
+		{"TestNewOpinionFromProbability8", args{0.75, 8, 0.5}, Opinion{0.6, 0.2, 0.2, 0.5}, false},
+		{"TestNewOpinionFromProbability9", args{1, 98, 0.5}, Opinion{0.98, 0, 0.02, 0.5}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOpinionFromProbability(tt.args.p, tt.args.n, tt.args.baseRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOpinionFromProbability() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("NewOpinionFromProbability() got = %v, want %v", &got, &tt.want)
+			}
+		})
+	}
+}
+
+func TestNewOpinionFromInterval(t *testing.T) {
+	type args struct {
+		lo       float64
+		hi       float64
+		baseRate float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Opinion
+		wantErr bool
+	}{
+		//invalid input
+		{"TestNewOpinionFromInterval1", args{0.6, 0.4, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromInterval2", args{-0.1, 0.4, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromInterval3", args{0.4, 1.1, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromInterval4", args{0.4, 0.6, -0.5}, Opinion{}, true},
+
+		//general tests
+		{"TestNewOpinionFromInterval5", args{0, 1, 0.5}, Opinion{0, 0, 1, 0.5}, false},
+		{"TestNewOpinionFromInterval6", args{0.7, 0.7, 0.5}, Opinion{0.7, 0.3, 0, 0.5}, false},
+		{"TestNewOpinionFromInterval7", args{0.6, 0.8, 0.5}, Opinion{0.6, 0.2, 0.2, 0.5}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOpinionFromInterval(tt.args.lo, tt.args.hi, tt.args.baseRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOpinionFromInterval() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("NewOpinionFromInterval() got = %v, want %v", &got, &tt.want)
+			}
+			if p := got.ProjectedProbability(); err == nil && (p < tt.args.lo || p > tt.args.hi) {
+				t.Errorf("ProjectedProbability() = %v lies outside of the interval", p)
+			}
+		})
+	}
+}
+
+func TestNewOpinionFromMoments(t *testing.T) {
+	type args struct {
+		mean     float64
+		variance float64
+		baseRate float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Opinion
+		wantErr bool
+	}{
+		//invalid input
+		{"TestNewOpinionFromMoments1", args{1.5, 0.01, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromMoments2", args{0.5, -0.01, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromMoments3", args{0.5, math.NaN(), 0.5}, Opinion{}, true},
+		// variances beyond that of the uniform distribution of a vacuous opinion cannot be represented
+		{"TestNewOpinionFromMoments4", args{0.5, 0.1, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromMoments5", args{0.9, 0.02, 0}, Opinion{}, true},
+
+		//general tests
+		{"TestNewOpinionFromMoments6", args{0.7, 0, 0.5}, Opinion{0.7, 0.3, 0, 0.5}, false},
+		{"TestNewOpinionFromMoments7", args{0.5, 0.02, 0.5}, Opinion{4.75 / 11.5, 4.75 / 11.5, 2 / 11.5, 0.5}, false},
+		// alpha = 6.4, beta = 3.6
+		{"TestNewOpinionFromMoments8", args{0.64, 0.64 * 0.36 / 11, 0.2}, Opinion{0.6, 0.2, 0.2, 0.2}, false},
+		// the uniform distribution alpha = beta = 1 is that of the vacuous opinion
+		{"TestNewOpinionFromMoments9", args{0.5, 1.0 / 12, 0.5}, Opinion{0, 0, 1, 0.5}, false},
+		// the vacuous variance only fits the base rate equal to the mean
+		{"TestNewOpinionFromMoments10", args{0.5, 1.0 / 12, 0.3}, Opinion{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOpinionFromMoments(tt.args.mean, tt.args.variance, tt.args.baseRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOpinionFromMoments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("NewOpinionFromMoments() got = %v, want %v", &got, &tt.want)
+			}
+		})
+	}
+}
+
+func TestMomentsRoundTrip(t *testing.T) {
+	for _, o := range []Opinion{{0.6, 0.3, 0.1, 0.5}, {0.2, 0.2, 0.6, 0.3}, {0, 0, 1, 0.5}, {0, 0, 1, 0.2}, {0, 0, 1, 1.0 / 3}} {
+		alpha, beta := o.BetaParameters()
+		mean, variance := alpha/(alpha+beta), alpha*beta/((alpha+beta)*(alpha+beta)*(alpha+beta+1))
+		got, err := NewOpinionFromMoments(mean, variance, o.BaseRate())
+		if err != nil || !got.Compare(o) {
+			t.Errorf("NewOpinionFromMoments() of the moments of %v got = %v, %v", &o, &got, err)
+		}
+	}
+}
@@ -0,0 +1,374 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"strconv"
+)
+
+/*
+Opinions holds a batch of opinions in structure-of-arrays layout: the i-th opinion is formed by the i-th value of each slice.
+*/
+type Opinions = OpinionsOf[float64]
+
+/*
+OpinionsOf is the generic counterpart of Opinions.
+*/
+type OpinionsOf[T Float] struct {
+	Belief      []T
+	Disbelief   []T
+	Uncertainty []T
+	BaseRate    []T
+}
+
+/*
+MakeOpinions returns a batch of n zeroed opinions, e.g. to hold the results of a batch function.
+*/
+func MakeOpinions(n int) Opinions {
+	return MakeOpinionsOf[float64](n)
+}
+
+/*
+MakeOpinionsOf is the generic counterpart of MakeOpinions.
+*/
+func MakeOpinionsOf[T Float](n int) OpinionsOf[T] {
+	return OpinionsOf[T]{Belief: make([]T, n), Disbelief: make([]T, n), Uncertainty: make([]T, n), BaseRate: make([]T, n)}
+}
+
+/*
+Len is called onto a batch os and returns the number of opinions in os.
+If the slices of os differ in length, the length of the shortest slice is returned.
+*/
+func (opinions OpinionsOf[T]) Len() int {
+	return min(len(opinions.Belief), len(opinions.Disbelief), len(opinions.Uncertainty), len(opinions.BaseRate))
+}
+
+/*
+At is called onto a batch os and returns its i-th opinion.
+*/
+func (opinions OpinionsOf[T]) At(i int) OpinionOf[T] {
+	return OpinionOf[T]{opinions.Belief[i], opinions.Disbelief[i], opinions.Uncertainty[i], opinions.BaseRate[i]}
+}
+
+/*
+Set is called onto a batch os and sets its i-th opinion to o.
+*/
+func (opinions OpinionsOf[T]) Set(i int, opinion OpinionOf[T]) {
+	opinions.Belief[i] = opinion.belief
+	opinions.Disbelief[i] = opinion.disbelief
+	opinions.Uncertainty[i] = opinion.uncertainty
+	opinions.BaseRate[i] = opinion.baseRate
+}
+
+/*
+Validate is called onto a batch os and returns an error, if the slices of os differ in length
+or one of its opinions is not a valid Opinion.
+*/
+func (opinions OpinionsOf[T]) Validate() error {
+	if err := opinions.checkLengths("Validate"); err != nil {
+		return err
+	}
+	tolerance := precision[T]()
+	for i := range opinions.Belief {
+		if err := checkBatchInput("Validate", i, opinions.At(i), tolerance, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (opinions OpinionsOf[T]) checkLengths(op string) error {
+	n := len(opinions.Belief)
+	if len(opinions.Disbelief) != n || len(opinions.Uncertainty) != n || len(opinions.BaseRate) != n {
+		return newOperatorError[T](op, ErrInvalidOpinion, "Slices of a batch must have the same length")
+	}
+	return nil
+}
+
+/*
+checkBatchInput returns a *BatchError for the function op, if the opinion o at index i of a batch is not valid
+or, if o is an operand, the null opinion.
+*/
+func checkBatchInput[T Float](op string, i int, opinion OpinionOf[T], tolerance float64, operand bool) error {
+	if operand && opinion == (OpinionOf[T]{}) {
+		return &BatchError{Index: i, Err: newOperatorError[T](op, ErrNullOpinion, "")}
+	}
+	if !checkTolerance(opinion.belief, opinion.disbelief, opinion.uncertainty, opinion.baseRate, tolerance) {
+		return &BatchError{Index: i, Err: newOperatorError[T](op, ErrInvalidOpinion, "")}
+	}
+	return nil
+}
+
+/*
+BatchError is returned by the batch functions, if the operator fails on the opinions at Index.
+*/
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+/*
+Error is called onto a *BatchError e and returns a message of the form "Index: Err".
+*/
+func (e *BatchError) Error() string {
+	return strconv.Itoa(e.Index) + ": " + e.Err.Error()
+}
+
+/*
+Unwrap is called onto a *BatchError e and returns the *OperatorError e wraps.
+*/
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+/*
+The batch functions below apply an operator to each pair of opinions at the same index of the batches x and y
+and store the result at that index in dst, which must be at least as long as the inputs.
+The inputs are validated in the same single pass that computes the results. If an input is invalid or the operator fails on a pair of opinions,
+a *BatchError holding its index is returned and dst holds the results of all preceding pairs.
+*/
+
+func AdditionBatch(dst, x, y Opinions) error {
+	return binaryBatch("Addition", additionValues[float64], addition[float64], dst, x, y)
+}
+
+func AveragingFusionBatch(dst, x, y Opinions) error {
+	return binaryBatch("AveragingFusion", averagingFusionValues[float64], averagingFusion[float64], dst, x, y)
+}
+
+func ComultiplicationBatch(dst, x, y Opinions) error {
+	return binaryBatch("Comultiplication", comultiplicationValues[float64], comultiplication[float64], dst, x, y)
+}
+
+func ConstraintFusionBatch(dst, x, y Opinions) error {
+	return binaryBatch("ConstraintFusion", constraintFusionValues[float64], constraintFusion[float64], dst, x, y)
+}
+
+func CumulativeFusionBatch(dst, x, y Opinions) error {
+	return binaryBatch("CumulativeFusion", cumulativeFusionValues[float64], cumulativeFusion[float64], dst, x, y)
+}
+
+func MultiplicationBatch(dst, x, y Opinions) error {
+	return binaryBatch("Multiplication", multiplicationValues[float64], multiplication[float64], dst, x, y)
+}
+
+func TrustDiscountingBatch(dst, x, y Opinions) error {
+	return binaryBatch("TrustDiscounting", trustDiscountingValues[float64], trustDiscounting[float64], dst, x, y)
+}
+
+func TrustDiscountingOppositeBeliefBatch(dst, x, y Opinions) error {
+	return binaryBatch("TrustDiscountingOppositeBelief", trustDiscountingOppositeBeliefValues[float64], trustDiscountingOppositeBelief[float64], dst, x, y)
+}
+
+func TrustDiscountingUncertaintyFavouringBatch(dst, x, y Opinions) error {
+	return binaryBatch("TrustDiscountingUncertaintyFavouring", trustDiscountingUncertaintyFavouringValues[float64], trustDiscountingUncertaintyFavouring[float64], dst, x, y)
+}
+
+func WeightedFusionBatch(dst, x, y Opinions) error {
+	return binaryBatch("WeightedFusion", weightedFusionValues[float64], weightedFusion[float64], dst, x, y)
+}
+
+/*
+ComplementBatch stores the complement of each opinion of x at the same index of dst.
+*/
+func ComplementBatch(dst, x Opinions) error {
+	return ComplementBatchOf(dst, x)
+}
+
+/*
+MultiEdgeTrustDiscBatch discounts one trust path per index like MultiEdgeTrustDisc and stores the result at that index of dst.
+The k-th batch of edges holds the k-th opinion of every path, so all paths have the same length len(edges), which must be at least 2.
+The last batch holds the opinions the paths end with.
+*/
+func MultiEdgeTrustDiscBatch(dst Opinions, edges []Opinions) error {
+	return MultiEdgeTrustDiscBatchOf(dst, edges)
+}
+
+/*
+The functions below are the generic counterparts of the batch functions above.
+*/
+
+func AdditionBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("Addition", additionValues[T], addition[T], dst, x, y)
+}
+
+func AveragingFusionBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("AveragingFusion", averagingFusionValues[T], averagingFusion[T], dst, x, y)
+}
+
+func ComultiplicationBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("Comultiplication", comultiplicationValues[T], comultiplication[T], dst, x, y)
+}
+
+func ConstraintFusionBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("ConstraintFusion", constraintFusionValues[T], constraintFusion[T], dst, x, y)
+}
+
+func CumulativeFusionBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("CumulativeFusion", cumulativeFusionValues[T], cumulativeFusion[T], dst, x, y)
+}
+
+func MultiplicationBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("Multiplication", multiplicationValues[T], multiplication[T], dst, x, y)
+}
+
+func TrustDiscountingBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("TrustDiscounting", trustDiscountingValues[T], trustDiscounting[T], dst, x, y)
+}
+
+func TrustDiscountingOppositeBeliefBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("TrustDiscountingOppositeBelief", trustDiscountingOppositeBeliefValues[T], trustDiscountingOppositeBelief[T], dst, x, y)
+}
+
+func TrustDiscountingUncertaintyFavouringBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("TrustDiscountingUncertaintyFavouring", trustDiscountingUncertaintyFavouringValues[T], trustDiscountingUncertaintyFavouring[T], dst, x, y)
+}
+
+func WeightedFusionBatchOf[T Float](dst, x, y OpinionsOf[T]) error {
+	return binaryBatch("WeightedFusion", weightedFusionValues[T], weightedFusion[T], dst, x, y)
+}
+
+func ComplementBatchOf[T Float](dst, x OpinionsOf[T]) error {
+	if err := x.checkLengths("Complement"); err != nil {
+		return err
+	}
+	if err := checkDestination[T]("Complement", dst, x.Len()); err != nil {
+		return err
+	}
+	tolerance := precision[T]()
+	e := Evaluator{Tolerance: tolerance}
+	for i := range x.Belief {
+		o := x.At(i)
+		if err := checkBatchInput("Complement", i, o, tolerance, false); err != nil {
+			return err
+		}
+		b, d, u, a, _ := complementValues(o)
+		b, d, u, a, ok := normalise(e, b, d, u, a)
+		if !ok {
+			_, err := complement(Evaluator{}, &o)
+			return &BatchError{Index: i, Err: err}
+		}
+		dst.Belief[i], dst.Disbelief[i], dst.Uncertainty[i], dst.BaseRate[i] = b, d, u, a
+	}
+	return nil
+}
+
+func MultiEdgeTrustDiscBatchOf[T Float](dst OpinionsOf[T], edges []OpinionsOf[T]) error {
+	if edges == nil {
+		return newOperatorError[T]("MultiEdgeTrustDisc", ErrNilInput, "")
+	}
+	if len(edges) < 2 {
+		return newOperatorError[T]("MultiEdgeTrustDisc", ErrUndefined, "At least two Opinions required")
+	}
+	n := edges[0].Len()
+	for _, x := range edges {
+		if err := x.checkLengths("MultiEdgeTrustDisc"); err != nil {
+			return err
+		}
+		if x.Len() != n {
+			return newOperatorError[T]("MultiEdgeTrustDisc", ErrInvalidOpinion, "Batches must have the same length")
+		}
+	}
+	if err := checkDestination[T]("MultiEdgeTrustDisc", dst, n); err != nil {
+		return err
+	}
+	tolerance := precision[T]()
+	e := Evaluator{Tolerance: tolerance}
+	last := edges[len(edges)-1]
+	for i := range n {
+		P_acc := T(1)
+		for _, x := range edges[:len(edges)-1] {
+			o := x.At(i)
+			if err := checkBatchInput("MultiEdgeTrustDisc", i, o, tolerance, false); err != nil {
+				return err
+			}
+			P_acc *= o.ProjectedProbability()
+		}
+		o := last.At(i)
+		if err := checkBatchInput("MultiEdgeTrustDisc", i, o, tolerance, false); err != nil {
+			return err
+		}
+		b := P_acc * o.belief
+		d := P_acc * o.disbelief
+		b, d, u, a, ok := normalise(e, b, d, 1-b-d, o.baseRate)
+		if !ok {
+			path := make([]OpinionOf[T], len(edges))
+			for k, x := range edges {
+				path[k] = x.At(i)
+			}
+			_, err := multiEdgeTrustDisc(Evaluator{}, path)
+			return &BatchError{Index: i, Err: err}
+		}
+		dst.Belief[i], dst.Disbelief[i], dst.Uncertainty[i], dst.BaseRate[i] = b, d, u, a
+	}
+	return nil
+}
+
+/*
+binaryBatch applies the binary operator op to the batches x and y as described above.
+The values of each result are computed by values, the pairwise operator f is only called to form the error for a failing pair.
+*/
+func binaryBatch[T Float](op string, values func(OpinionOf[T], OpinionOf[T]) (T, T, T, T, *failure),
+	f func(Evaluator, *OpinionOf[T], *OpinionOf[T]) (OpinionOf[T], error), dst, x, y OpinionsOf[T]) error {
+	if err := x.checkLengths(op); err != nil {
+		return err
+	}
+	if err := y.checkLengths(op); err != nil {
+		return err
+	}
+	n := x.Len()
+	if y.Len() != n {
+		return newOperatorError[T](op, ErrInvalidOpinion, "Batches must have the same length")
+	}
+	if err := checkDestination[T](op, dst, n); err != nil {
+		return err
+	}
+	tolerance := precision[T]()
+	e := Evaluator{Tolerance: tolerance}
+	for i := range n {
+		o1, o2 := x.At(i), y.At(i)
+		if err := checkBatchInput(op, i, o1, tolerance, true); err != nil {
+			return err
+		}
+		if err := checkBatchInput(op, i, o2, tolerance, true); err != nil {
+			return err
+		}
+		b, d, u, a, fail := values(o1, o2)
+		ok := fail == nil
+		if ok {
+			b, d, u, a, ok = normalise(e, b, d, u, a)
+		}
+		if !ok {
+			return &BatchError{Index: i, Err: pairError(f, o1, o2)}
+		}
+		dst.Belief[i], dst.Disbelief[i], dst.Uncertainty[i], dst.BaseRate[i] = b, d, u, a
+	}
+	return nil
+}
+
+/*
+pairError returns the error of the pairwise operator f for the opinions o1 and o2.
+*/
+func pairError[T Float](f func(Evaluator, *OpinionOf[T], *OpinionOf[T]) (OpinionOf[T], error), opinion1, opinion2 OpinionOf[T]) error {
+	_, err := f(Evaluator{}, &opinion1, &opinion2)
+	return err
+}
+
+func checkDestination[T Float](op string, dst OpinionsOf[T], n int) error {
+	if dst.Len() < n {
+		return newOperatorError[T](op, ErrInvalidOpinion, "Destination batch is too short")
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	type operator struct {
+		batch    func(dst, x, y Opinions) error
+		pairwise func(*Opinion, *Opinion) (Opinion, error)
+	}
+	operators := map[string]operator{
+		"Addition":                       {AdditionBatch, Addition},
+		"AveragingFusion":                {AveragingFusionBatch, AveragingFusion},
+		"Comultiplication":               {ComultiplicationBatch, Comultiplication},
+		"ConstraintFusion":               {ConstraintFusionBatch, ConstraintFusion},
+		"CumulativeFusion":               {CumulativeFusionBatch, CumulativeFusion},
+		"Multiplication":                 {MultiplicationBatch, Multiplication},
+		"TrustDiscounting":               {TrustDiscountingBatch, TrustDiscounting},
+		"TrustDiscountingOppositeBelief": {TrustDiscountingOppositeBeliefBatch, TrustDiscountingOppositeBelief},
+		"WeightedFusion":                 {WeightedFusionBatch, WeightedFusion},
+	}
+
+	for name, op := range operators {
+		// all pairs of valid opinions the pairwise operator succeeds on
+		var x, y, want Opinions
+		for i := 0; i < nrOfValidOpinions; i++ {
+			for j := 0; j < nrOfValidOpinions; j++ {
+				v1, v2 := testValuesOpinions[i], testValuesOpinions[j]
+				o1, o2 := Opinion{v1[0], v1[1], v1[2], v1[3]}, Opinion{v2[0], v2[1], v2[2], v2[3]}
+				r, err := op.pairwise(&o1, &o2)
+				if err != nil {
+					continue
+				}
+				x = appendOpinion(x, o1)
+				y = appendOpinion(y, o2)
+				want = appendOpinion(want, r)
+			}
+		}
+
+		dst := MakeOpinions(x.Len())
+		if err := op.batch(dst, x, y); err != nil {
+			t.Errorf("%sBatch() False negative | Error: %s", name, err)
+			continue
+		}
+		for i := range want.Len() {
+			if dst.At(i) != want.At(i) {
+				t.Errorf("%sBatch() Incorrect output at %d | Output: %v | Expected: %v", name, i, dst.At(i), want.At(i))
+			}
+		}
+	}
+}
+
+func TestBatch_Errors(t *testing.T) {
+	valid := Opinion{0.6, 0.3, 0.1, 0.5}
+	x := appendOpinion(appendOpinion(Opinions{}, valid), valid)
+
+	tests := []struct {
+		name   string
+		dst    Opinions
+		x      Opinions
+		y      Opinions
+		target error
+		index  int
+	}{
+		{"TestBatch_Errors1", MakeOpinions(2), x, appendOpinion(appendOpinion(Opinions{}, valid), Opinion{0.5, 0.5, 0.5, 0.5}), ErrInvalidOpinion, 1},
+		{"TestBatch_Errors2", MakeOpinions(2), x, appendOpinion(appendOpinion(Opinions{}, Opinion{}), valid), ErrNullOpinion, 0},
+		{"TestBatch_Errors3", MakeOpinions(2), x, appendOpinion(Opinions{}, valid), ErrInvalidOpinion, -1},
+		{"TestBatch_Errors4", MakeOpinions(1), x, x, ErrInvalidOpinion, -1},
+		{"TestBatch_Errors5", MakeOpinions(2), x, Opinions{Belief: []float64{0.6, 0.6}}, ErrInvalidOpinion, -1},
+		{"TestBatch_Errors6", MakeOpinions(2), appendOpinion(appendOpinion(Opinions{}, valid), Opinion{1, 0, 0, 0.5}), appendOpinion(appendOpinion(Opinions{}, valid), Opinion{0, 1, 0, 0.5}),
+			ErrTotalConflict, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ConstraintFusionBatch(tt.dst, tt.x, tt.y)
+			if !errors.Is(err, tt.target) {
+				t.Fatalf("ConstraintFusionBatch() error = %v, want %v", err, tt.target)
+			}
+			var batchErr *BatchError
+			if errors.As(err, &batchErr) != (tt.index >= 0) || (batchErr != nil && batchErr.Index != tt.index) {
+				t.Errorf("ConstraintFusionBatch() error = %v, want index %d", err, tt.index)
+			}
+			var opErr *OperatorError
+			if !errors.As(err, &opErr) || opErr.Op != "ConstraintFusion" {
+				t.Errorf("ConstraintFusionBatch() error = %v, want *OperatorError of ConstraintFusion", err)
+			}
+		})
+	}
+}
+
+func TestBatch_Complement(t *testing.T) {
+	var x Opinions
+	for i := 0; i < nrOfValidOpinions; i++ {
+		v := testValuesOpinions[i]
+		x = appendOpinion(x, Opinion{v[0], v[1], v[2], v[3]})
+	}
+	if err := x.Validate(); err != nil {
+		t.Fatalf("Validate() False negative | Error: %s", err)
+	}
+	dst := MakeOpinions(x.Len())
+	if err := ComplementBatch(dst, x); err != nil {
+		t.Fatalf("ComplementBatch() False negative | Error: %s", err)
+	}
+	for i := range x.Len() {
+		o := x.At(i)
+		want, _ := Complement(&o)
+		if dst.At(i) != want {
+			t.Errorf("ComplementBatch() Incorrect output at %d | Output: %v | Expected: %v", i, dst.At(i), want)
+		}
+	}
+
+	x.Set(3, Opinion{0.5, 0.3, 0.7, 0.5})
+	var batchErr *BatchError
+	if err := x.Validate(); !errors.As(err, &batchErr) || batchErr.Index != 3 {
+		t.Errorf("Validate() error = %v, want index 3", err)
+	}
+}
+
+func TestBatch_MultiEdgeTrustDisc(t *testing.T) {
+	paths := [][]Opinion{
+		{{0.6, 0.3, 0.1, 0.5}, {0.2, 0.2, 0.6, 0.5}, {0.091, 0.604, 0.305, 1}},
+		{{1, 0, 0, 0.5}, {1, 0, 0, 0.5}, {0.5, 0.3, 0.2, 0.4}},
+		{{0, 0, 1, 0}, {0.7, 0.1, 0.2, 0.5}, {0.5, 0.3, 0.2, 0.4}},
+		{{0.2, 0.8, 0, 0.5}, {0.7, 0.1, 0.2, 0.5}, {0, 0, 1, 0.4}},
+	}
+	edges := make([]Opinions, 3)
+	for _, path := range paths {
+		for k, o := range path {
+			edges[k] = appendOpinion(edges[k], o)
+		}
+	}
+	dst := MakeOpinions(len(paths))
+	if err := MultiEdgeTrustDiscBatch(dst, edges); err != nil {
+		t.Fatalf("MultiEdgeTrustDiscBatch() False negative | Error: %s", err)
+	}
+	for i, path := range paths {
+		want, _ := MultiEdgeTrustDisc(path)
+		if dst.At(i) != want {
+			t.Errorf("MultiEdgeTrustDiscBatch() Incorrect output at %d | Output: %v | Expected: %v", i, dst.At(i), want)
+		}
+	}
+
+	invalid := append([]Opinions{}, edges...)
+	invalid[1] = appendOpinion(appendOpinion(appendOpinion(appendOpinion(Opinions{}, paths[0][1]), paths[1][1]), Opinion{0.5, 0.5, 0.5, 0.5}), paths[3][1])
+	var batchErr *BatchError
+	if err := MultiEdgeTrustDiscBatch(dst, invalid); !errors.As(err, &batchErr) || batchErr.Index != 2 || !errors.Is(err, ErrInvalidOpinion) {
+		t.Errorf("MultiEdgeTrustDiscBatch() error = %v, want %v at index 2", err, ErrInvalidOpinion)
+	}
+	if err := MultiEdgeTrustDiscBatch(dst, edges[:1]); !errors.Is(err, ErrUndefined) {
+		t.Errorf("MultiEdgeTrustDiscBatch() error = %v, want %v", err, ErrUndefined)
+	}
+	if err := MultiEdgeTrustDiscBatch(dst, nil); !errors.Is(err, ErrNilInput) {
+		t.Errorf("MultiEdgeTrustDiscBatch() error = %v, want %v", err, ErrNilInput)
+	}
+	if err := MultiEdgeTrustDiscBatch(dst, []Opinions{edges[0], appendOpinion(Opinions{}, paths[0][1])}); !errors.Is(err, ErrInvalidOpinion) {
+		t.Errorf("MultiEdgeTrustDiscBatch() error = %v, want %v", err, ErrInvalidOpinion)
+	}
+}
+
+func TestBatch_Float32(t *testing.T) {
+	x, y, dst := MakeOpinionsOf[float32](2), MakeOpinionsOf[float32](2), MakeOpinionsOf[float32](2)
+	x.Set(0, OpinionOf[float32]{0.6, 0.3, 0.1, 0.5})
+	x.Set(1, OpinionOf[float32]{0.2, 0.2, 0.6, 0.5})
+	y.Set(0, OpinionOf[float32]{0.091, 0.604, 0.305, 1})
+	y.Set(1, OpinionOf[float32]{0, 0, 1, 0.5})
+	if err := CumulativeFusionBatchOf(dst, x, y); err != nil {
+		t.Fatalf("CumulativeFusionBatchOf() False negative | Error: %s", err)
+	}
+	for i := range 2 {
+		o1, o2 := x.At(i), y.At(i)
+		want, _ := CumulativeFusionOf(&o1, &o2)
+		if dst.At(i) != want {
+			t.Errorf("CumulativeFusionBatchOf() Incorrect output at %d | Output: %v | Expected: %v", i, dst.At(i), want)
+		}
+	}
+}
+
+func appendOpinion(opinions Opinions, opinion Opinion) Opinions {
+	opinions.Belief = append(opinions.Belief, opinion.belief)
+	opinions.Disbelief = append(opinions.Disbelief, opinion.disbelief)
+	opinions.Uncertainty = append(opinions.Uncertainty, opinion.uncertainty)
+	opinions.BaseRate = append(opinions.BaseRate, opinion.baseRate)
+	return opinions
+}
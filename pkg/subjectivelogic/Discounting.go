@@ -0,0 +1,98 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+/*
+Discounter discounts an Opinion by the trust in its source. The implementations of this package are the variants of trust discounting,
+so the semantics of discounting can be chosen per deployment.
+*/
+type Discounter interface {
+	Discount(trust *Opinion, opinion *Opinion) (Opinion, error)
+}
+
+/*
+DiscounterFunc is a function that is used as a Discounter, e.g. one of the trust discounting operators.
+*/
+type DiscounterFunc func(trust *Opinion, opinion *Opinion) (Opinion, error)
+
+/*
+Discount is called onto a DiscounterFunc f and returns f(trust, opinion).
+*/
+func (f DiscounterFunc) Discount(trust *Opinion, opinion *Opinion) (Opinion, error) {
+	return f(trust, opinion)
+}
+
+var (
+	// ProbabilityDiscounter discounts by the projected probability of the trust Opinion, see TrustDiscounting.
+	ProbabilityDiscounter Discounter = DiscounterFunc(TrustDiscounting)
+	// OppositeBeliefDiscounter lets distrust turn the belief of the source into disbelief, see TrustDiscountingOppositeBelief.
+	OppositeBeliefDiscounter Discounter = DiscounterFunc(TrustDiscountingOppositeBelief)
+	// UncertaintyFavouringDiscounter discounts by the belief mass of the trust Opinion, see TrustDiscountingUncertaintyFavouring.
+	UncertaintyFavouringDiscounter Discounter = DiscounterFunc(TrustDiscountingUncertaintyFavouring)
+)
+
+/*
+MappedDiscounter discounts an Opinion by the probability p that Mapping assigns to the trust Opinion, i.e. the result is formed as
+b = p*b_x, d = p*d_x, u = 1 - p*(b_x+d_x), a = a_x. Mapping the trust Opinion to its projected probability yields TrustDiscounting,
+mapping it to its belief mass yields TrustDiscountingUncertaintyFavouring.
+The zero value uses the projected probability.
+*/
+type MappedDiscounter struct {
+	Mapping func(trust *Opinion) float64
+}
+
+/*
+Discount is called onto a MappedDiscounter m and discounts the Opinion opinion by the trust Opinion trust.
+If an input is nil or the null opinion, or Mapping yields a value outside of [0, 1], a zeroed Opinion and an error are returned.
+*/
+func (m MappedDiscounter) Discount(trust *Opinion, opinion *Opinion) (Opinion, error) {
+	if err := checkOperands("MappedDiscounter", trust, opinion); err != nil {
+		return Opinion{}, err
+	}
+	p := trust.ProjectedProbability()
+	if m.Mapping != nil {
+		p = m.Mapping(trust)
+	}
+	if !(0 <= p && p <= 1) {
+		return Opinion{}, newOperatorError("MappedDiscounter", ErrUndefined, "Mapping must yield a value in [0, 1]", trust, opinion)
+	}
+	b := p * opinion.belief
+	d := p * opinion.disbelief
+	return newResult(Evaluator{}, "MappedDiscounter", b, d, 1-b-d, opinion.baseRate, trust, opinion)
+}
+
+/*
+DiscountPath discounts the last of the Opinions along the trust path formed by the others with the Discounter d,
+starting at the end of the path, i.e. [A;B;C] is discounted as A ⊗ (B ⊗ C).
+For ProbabilityDiscounter, the result equals MultiEdgeTrustDisc.
+If fewer than two Opinions are given or d fails, a zeroed Opinion and an error are returned.
+*/
+func DiscountPath(d Discounter, opinions []Opinion) (Opinion, error) {
+	if d == nil || opinions == nil {
+		return Opinion{}, newOperatorError[float64]("DiscountPath", ErrNilInput, "")
+	}
+	n := len(opinions)
+	if n < 2 {
+		return Opinion{}, newOperatorError[float64]("DiscountPath", ErrUndefined, "At least two Opinions required")
+	}
+	result := opinions[n-1]
+	for i := n - 2; i >= 0; i-- {
+		var err error
+		if result, err = d.Discount(&opinions[i], &result); err != nil {
+			return Opinion{}, err
+		}
+	}
+	return result, nil
+}
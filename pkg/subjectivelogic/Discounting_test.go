@@ -0,0 +1,94 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiscounter(t *testing.T) {
+	trust := Opinion{0.6, 0.1, 0.3, 0.5}
+	opinion := Opinion{0.5, 0.3, 0.2, 0.4}
+	e := Evaluator{Tolerance: 1e-9}
+
+	tests := []struct {
+		name       string
+		discounter Discounter
+		want       func(*Opinion, *Opinion) (Opinion, error)
+	}{
+		{"ProbabilityDiscounter", ProbabilityDiscounter, TrustDiscounting},
+		{"OppositeBeliefDiscounter", OppositeBeliefDiscounter, TrustDiscountingOppositeBelief},
+		{"UncertaintyFavouringDiscounter", UncertaintyFavouringDiscounter, TrustDiscountingUncertaintyFavouring},
+		{"MappedDiscounter", MappedDiscounter{}, TrustDiscounting},
+		{"MappedDiscounter belief", MappedDiscounter{Mapping: func(o *Opinion) float64 { return o.Belief() }}, TrustDiscountingUncertaintyFavouring},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.discounter.Discount(&trust, &opinion)
+			want, _ := tt.want(&trust, &opinion)
+			if err != nil || !e.Compare(got, want) {
+				t.Errorf("Discount() got = %v, %v, want %v", &got, err, &want)
+			}
+		})
+	}
+
+	// a trust-to-belief mapping with a threshold
+	threshold := MappedDiscounter{Mapping: func(o *Opinion) float64 {
+		if o.ProjectedProbability() < 0.5 {
+			return 0
+		}
+		return 1
+	}}
+	if got, err := threshold.Discount(&trust, &opinion); err != nil || got != opinion {
+		t.Errorf("Discount() got = %v, %v, want %v", &got, err, &opinion)
+	}
+	distrust := Opinion{0.1, 0.6, 0.3, 0.5}
+	if got, err := threshold.Discount(&distrust, &opinion); err != nil || got != (Opinion{0, 0, 1, 0.4}) {
+		t.Errorf("Discount() got = %v, %v, want the vacuous opinion", &got, err)
+	}
+
+	invalid := MappedDiscounter{Mapping: func(*Opinion) float64 { return 1.5 }}
+	if _, err := invalid.Discount(&trust, &opinion); !errors.Is(err, ErrUndefined) {
+		t.Errorf("Discount() error = %v, want %v", err, ErrUndefined)
+	}
+	if _, err := (MappedDiscounter{}).Discount(nil, &opinion); !errors.Is(err, ErrNilInput) {
+		t.Errorf("Discount() error = %v, want %v", err, ErrNilInput)
+	}
+}
+
+func TestDiscountPath(t *testing.T) {
+	path := []Opinion{{0.8, 0.1, 0.1, 0.5}, {0.7, 0.2, 0.1, 0.5}, {0.6, 0.2, 0.2, 0.5}}
+
+	got, err := DiscountPath(ProbabilityDiscounter, path)
+	want, _ := MultiEdgeTrustDisc(path)
+	if err != nil || !(Evaluator{Tolerance: 1e-9}).Compare(got, want) {
+		t.Errorf("DiscountPath() got = %v, %v, want %v", &got, err, &want)
+	}
+
+	// b = 0.8 * 0.7 * 0.6, d = 0.8 * 0.7 * 0.2
+	got, err = DiscountPath(UncertaintyFavouringDiscounter, path)
+	want = Opinion{0.336, 0.112, 0.552, 0.5}
+	if err != nil || !got.Compare(want) {
+		t.Errorf("DiscountPath() got = %v, %v, want %v", &got, err, &want)
+	}
+
+	if _, err := DiscountPath(ProbabilityDiscounter, path[:1]); !errors.Is(err, ErrUndefined) {
+		t.Errorf("DiscountPath() error = %v, want %v", err, ErrUndefined)
+	}
+	if _, err := DiscountPath(nil, path); !errors.Is(err, ErrNilInput) {
+		t.Errorf("DiscountPath() error = %v, want %v", err, ErrNilInput)
+	}
+}
@@ -0,0 +1,118 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+)
+
+/*
+The sentinel errors below describe why a function of this package failed.
+Every error returned by the package is an *OperatorError wrapping one of them, so they can be tested for with errors.Is.
+*/
+var (
+	// ErrNilInput is returned if an input *Opinion is nil.
+	ErrNilInput = errors.New("Input cannot be nil")
+	// ErrNullOpinion is returned if an input Opinion is the null opinion (0, 0, 0, 0).
+	ErrNullOpinion = errors.New("Inputs cannot be null opinions")
+	// ErrInvalidOpinion is returned if input values or the result of an operator do not form a valid Opinion.
+	ErrInvalidOpinion = errors.New("Invalid Input")
+	// ErrUndefined is returned if an operator is mathematically undefined for its inputs, e.g. due to a division by zero.
+	ErrUndefined = errors.New("Operator is undefined for the inputs")
+	// ErrTotalConflict is returned if a fusion operator is applied to totally conflicting opinions.
+	ErrTotalConflict = errors.New("Inputs are totally conflicting")
+	// ErrUnknownOperator is returned if no operator is registered under a name in a Registry.
+	ErrUnknownOperator = errors.New("Unknown operator")
+	// ErrDuplicateOperator is returned if an operator is registered under a name that is already taken.
+	ErrDuplicateOperator = errors.New("Operator is already registered")
+)
+
+/*
+OperatorError is the error returned by the operators and functions of this package.
+Op is the name of the failing function, Err is one of the sentinel errors of this package and Inputs holds copies of the
+input opinions the function failed on, with nil inputs being recorded as zeroed Opinions.
+Reason optionally describes the failure in more detail than Err.
+*/
+type OperatorError struct {
+	Op     string
+	Err    error
+	Reason string
+	Inputs []Opinion
+}
+
+/*
+Error is called onto an *OperatorError e and returns a message of the form "Op: Reason", or "Op: Err" if e has no Reason.
+*/
+func (e *OperatorError) Error() string {
+	if e.Reason != "" {
+		return e.Op + ": " + e.Reason
+	}
+	return e.Op + ": " + e.Err.Error()
+}
+
+/*
+Unwrap is called onto an *OperatorError e and returns the sentinel error e wraps.
+*/
+func (e *OperatorError) Unwrap() error {
+	return e.Err
+}
+
+/*
+newOperatorError returns an *OperatorError for the function op, copying the values of the given inputs converted to float64.
+*/
+func newOperatorError[T Float](op string, err error, reason string, inputs ...*OpinionOf[T]) error {
+	e := &OperatorError{Op: op, Err: err, Reason: reason, Inputs: make([]Opinion, len(inputs))}
+	for i, input := range inputs {
+		if input != nil {
+			e.Inputs[i] = Opinion{float64(input.belief), float64(input.disbelief), float64(input.uncertainty), float64(input.baseRate)}
+		}
+	}
+	return e
+}
+
+/*
+failure describes why an operator is undefined for its inputs, before the inputs are known to the *OperatorError.
+*/
+type failure struct {
+	err    error
+	reason string
+}
+
+/*
+checkOperands returns an *OperatorError for the function op, if one of its inputs is nil or a null opinion.
+Otherwise, nil is returned.
+*/
+func checkOperands[T Float](op string, opinion1, opinion2 *OpinionOf[T]) error {
+	if opinion1 == nil || opinion2 == nil {
+		return newOperatorError(op, ErrNilInput, "", opinion1, opinion2)
+	}
+	nullChecker := OpinionOf[T]{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
+	if *opinion1 == nullChecker || *opinion2 == nullChecker {
+		return newOperatorError(op, ErrNullOpinion, "", opinion1, opinion2)
+	}
+	return nil
+}
+
+/*
+newResult forms the Opinion resulting from the function op, normalising the values according to e.
+If the values do not form a valid Opinion, a zeroed Opinion and an *OperatorError wrapping ErrInvalidOpinion are returned.
+*/
+func newResult[T Float](e Evaluator, op string, b, d, u, a T, inputs ...*OpinionOf[T]) (OpinionOf[T], error) {
+	b, d, u, a, ok := normalise(e, b, d, u, a)
+	if !ok {
+		return OpinionOf[T]{}, newOperatorError(op, ErrInvalidOpinion, "Result is not a valid opinion", inputs...)
+	}
+	return OpinionOf[T]{belief: b, disbelief: d, uncertainty: u, baseRate: a}, nil
+}
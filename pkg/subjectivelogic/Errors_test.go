@@ -0,0 +1,105 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOperatorError(t *testing.T) {
+	valid := &Opinion{0.6, 0.3, 0.1, 0.5}
+	null := &Opinion{}
+	binary := map[string]func(*Opinion, *Opinion) (Opinion, error){
+		"Addition":                       Addition,
+		"AveragingFusion":                AveragingFusion,
+		"Comultiplication":               Comultiplication,
+		"ConstraintFusion":               ConstraintFusion,
+		"CumulativeFusion":               CumulativeFusion,
+		"Multiplication":                 Multiplication,
+		"TrustDiscounting":               TrustDiscounting,
+		"TrustDiscountingOppositeBelief": TrustDiscountingOppositeBelief,
+		"WeightedFusion":                 WeightedFusion,
+	}
+	for name, op := range binary {
+		_, err := op(nil, valid)
+		checkOperatorError(t, err, name, ErrNilInput, []Opinion{{}, *valid})
+
+		_, err = op(valid, null)
+		checkOperatorError(t, err, name, ErrNullOpinion, []Opinion{*valid, {}})
+		if err.Error() != name+": Inputs cannot be null opinions" {
+			t.Errorf("%s() error message = %q", name, err.Error())
+		}
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		op     string
+		target error
+		inputs []Opinion
+	}{
+		{"TestOperatorError1", second(NewOpinion(0.5, 0.5, 0.5, 0.5)), "NewOpinion", ErrInvalidOpinion, []Opinion{}},
+		{"TestOperatorError2", (*Opinion)(nil).Modify(1, 0, 0, 0), "Modify", ErrNilInput, []Opinion{}},
+		{"TestOperatorError3", second(Complement(nil)), "Complement", ErrNilInput, []Opinion{{}}},
+		{"TestOperatorError4", second(Addition(&Opinion{0.5, 0.5, 0, 0}, &Opinion{0.5, 0.5, 0, 0})), "Addition", ErrUndefined,
+			[]Opinion{{0.5, 0.5, 0, 0}, {0.5, 0.5, 0, 0}}},
+		{"TestOperatorError5", second(Addition(&Opinion{1, 0, 0, 0.5}, &Opinion{1, 0, 0, 0.5})), "Addition", ErrInvalidOpinion,
+			[]Opinion{{1, 0, 0, 0.5}, {1, 0, 0, 0.5}}},
+		{"TestOperatorError6", second(Multiplication(&Opinion{1, 0, 0, 1}, &Opinion{0, 1, 0, 1})), "Multiplication", ErrUndefined,
+			[]Opinion{{1, 0, 0, 1}, {0, 1, 0, 1}}},
+		{"TestOperatorError7", second(Comultiplication(&Opinion{1, 0, 0, 0}, &Opinion{0, 1, 0, 0})), "Comultiplication", ErrUndefined,
+			[]Opinion{{1, 0, 0, 0}, {0, 1, 0, 0}}},
+		{"TestOperatorError8", second(ConstraintFusion(&Opinion{1, 0, 0, 0.5}, &Opinion{0, 1, 0, 0.5})), "ConstraintFusion", ErrTotalConflict,
+			[]Opinion{{1, 0, 0, 0.5}, {0, 1, 0, 0.5}}},
+		{"TestOperatorError9", second(MultiEdgeTrustDisc(nil)), "MultiEdgeTrustDisc", ErrNilInput, []Opinion{}},
+		{"TestOperatorError10", second(MultiEdgeTrustDisc([]Opinion{{1, 0, 0, 0.5}})), "MultiEdgeTrustDisc", ErrUndefined, []Opinion{}},
+		{"TestOperatorError11", second(NewOpinionFromEvidence(-1, 0, 0.5)), "NewOpinionFromEvidence", ErrInvalidOpinion, []Opinion{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkOperatorError(t, tt.err, tt.op, tt.target, tt.inputs)
+		})
+	}
+}
+
+func second(_ Opinion, err error) error {
+	return err
+}
+
+func checkOperatorError(t *testing.T, err error, op string, target error, inputs []Opinion) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Errorf("%s() error = %v, want %v", op, err, target)
+		return
+	}
+	var opErr *OperatorError
+	if !errors.As(err, &opErr) {
+		t.Errorf("%s() error = %v is no *OperatorError", op, err)
+		return
+	}
+	if opErr.Op != op {
+		t.Errorf("%s() error reports operator %q", op, opErr.Op)
+	}
+	if len(opErr.Inputs) != len(inputs) {
+		t.Errorf("%s() error reports inputs %v, want %v", op, opErr.Inputs, inputs)
+		return
+	}
+	for i := range inputs {
+		if opErr.Inputs[i] != inputs[i] {
+			t.Errorf("%s() error reports inputs %v, want %v", op, opErr.Inputs, inputs)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+)
+
+/*
+Normalisation defines how an Evaluator treats values that do not exactly form a valid Opinion,
+e.g. because floating-point errors accumulated over a long chain of operators.
+*/
+type Normalisation int
+
+const (
+	// Strict rejects values outside of [0, 1] and values with |1-(b+d+u)| >= 3*Tolerance. Accepted values are kept as they are.
+	Strict Normalisation = iota
+	// Renormalise clamps values that lie less than Tolerance outside of [0, 1] and rescales b, d and u to b+d+u = 1,
+	// if |1-(b+d+u)| < 3*Tolerance. Other values are rejected.
+	Renormalise
+	// Clamp clamps values that lie less than 3*Tolerance outside of [0, 1] and rejects them if |1-(b+d+u)| >= 3*Tolerance afterwards.
+	// Values further outside of [0, 1] are rejected.
+	Clamp
+)
+
+/*
+Evaluator holds the numeric tolerance and the Normalisation applied by its NewOpinion method and its operators,
+which are otherwise identical to the functions of the same name.
+The zero value is ready to use and behaves like the functions of this package, i.e. it uses Strict normalisation with Precision as tolerance.
+*/
+type Evaluator struct {
+	// Tolerance is the maximum deviance tolerated by the Normalisation. Precision, or Precision32 for float32 values, is used if Tolerance is not positive.
+	Tolerance     float64
+	Normalisation Normalisation
+}
+
+/*
+toleranceOf returns the tolerance of e for opinions with values of type T.
+*/
+func toleranceOf[T Float](e Evaluator) float64 {
+	if e.Tolerance > 0 {
+		return e.Tolerance
+	}
+	return precision[T]()
+}
+
+/*
+normalise applies the Normalisation of e to the values b, d, u, a and returns the normalised values,
+as well as whether they form a valid Opinion.
+*/
+func normalise[T Float](e Evaluator, b, d, u, a T) (T, T, T, T, bool) {
+	tolerance := toleranceOf[T](e)
+
+	switch e.Normalisation {
+	case Renormalise:
+		for _, v := range [4]T{b, d, u, a} {
+			if !(-tolerance < float64(v) && float64(v) < 1+tolerance) {
+				return b, d, u, a, false
+			}
+		}
+		b, d, u, a = clamp(b), clamp(d), clamp(u), clamp(a)
+		sum := b + d + u
+		if math.Abs(1-float64(sum)) >= 3*tolerance {
+			return b, d, u, a, false
+		}
+		return b / sum, d / sum, u / sum, a, true
+	case Clamp:
+		for _, v := range [4]T{b, d, u, a} {
+			if !(-3*tolerance < float64(v) && float64(v) < 1+3*tolerance) {
+				return b, d, u, a, false
+			}
+		}
+		b, d, u, a = clamp(b), clamp(d), clamp(u), clamp(a)
+	default:
+		// float32 rounding regularly pushes values like a base rate of 1 slightly out of [0, 1]
+		if _, ok := any(b).(float32); ok {
+			b, d, u, a = snap(b, tolerance), snap(d, tolerance), snap(u, tolerance), snap(a, tolerance)
+		}
+	}
+	return b, d, u, a, checkTolerance(b, d, u, a, tolerance)
+}
+
+/*
+NewOpinion is called onto an Evaluator e and forms an Opinion from the input values like the function NewOpinion,
+normalising them according to e.
+*/
+func (e Evaluator) NewOpinion(belief, disbelief, uncertainty, baseRate float64) (Opinion, error) {
+	b, d, u, a, ok := normalise(e, belief, disbelief, uncertainty, baseRate)
+	if !ok {
+		return Opinion{}, newOperatorError[float64]("NewOpinion", ErrInvalidOpinion, "")
+	}
+	return Opinion{belief: b, disbelief: d, uncertainty: u, baseRate: a}, nil
+}
+
+/*
+Compare is called onto an Evaluator e and compares the Opinions o1 and o2 like Opinion.Compare,
+but with the tolerance of e as maximum difference.
+*/
+func (e Evaluator) Compare(opinion1, opinion2 Opinion) bool {
+	return compare(e, opinion1, opinion2)
+}
+
+func compare[T Float](e Evaluator, opinion1, opinion2 OpinionOf[T]) bool {
+	tolerance := toleranceOf[T](e)
+	return math.Abs(float64(opinion1.belief-opinion2.belief)) < tolerance &&
+		math.Abs(float64(opinion1.disbelief-opinion2.disbelief)) < tolerance &&
+		math.Abs(float64(opinion1.uncertainty-opinion2.uncertainty)) < tolerance &&
+		math.Abs(float64(opinion1.baseRate-opinion2.baseRate)) < tolerance
+}
+
+func clamp[T Float](v T) T {
+	return max(0, min(1, v))
+}
+
+/*
+snap clamps v to [0, 1], if it lies less than tolerance outside of the interval.
+*/
+func snap[T Float](v T, tolerance float64) T {
+	if -tolerance < float64(v) && float64(v) < 1+tolerance {
+		return clamp(v)
+	}
+	return v
+}
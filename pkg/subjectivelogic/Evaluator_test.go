@@ -0,0 +1,110 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEvaluator_NewOpinion(t *testing.T) {
+	tests := []struct {
+		name      string
+		evaluator Evaluator
+		values    [4]float64
+		want      Opinion
+		wantErr   bool
+	}{
+		{"TestEvaluator_NewOpinion1", Evaluator{}, [4]float64{0.5, 0.3, 0.2, 0.5}, Opinion{0.5, 0.3, 0.2, 0.5}, false},
+		{"TestEvaluator_NewOpinion2", Evaluator{}, [4]float64{0.5, 0.3, 0.2 + 1e-9, 0.5}, Opinion{}, true},
+		{"TestEvaluator_NewOpinion3", Evaluator{Tolerance: 1e-6}, [4]float64{0.5, 0.3, 0.2 + 1e-9, 0.5}, Opinion{0.5, 0.3, 0.2 + 1e-9, 0.5}, false},
+		{"TestEvaluator_NewOpinion4", Evaluator{Tolerance: 1e-6}, [4]float64{0.5, 0.5 + 1e-9, -1e-9, 0.5}, Opinion{}, true},
+		{"TestEvaluator_NewOpinion5", Evaluator{Tolerance: 1e-6, Normalisation: Renormalise}, [4]float64{0.5, 0.5 + 1e-9, -1e-9, 0.5}, Opinion{0.5 - 5e-10, 0.5 + 5e-10, 0, 0.5}, false},
+		{"TestEvaluator_NewOpinion6", Evaluator{Tolerance: 1e-6, Normalisation: Renormalise}, [4]float64{0.5, 0.3, 0.2 + 2e-6, 0.5}, Opinion{0.5 / (1 + 2e-6), 0.3 / (1 + 2e-6), (0.2 + 2e-6) / (1 + 2e-6), 0.5}, false},
+		{"TestEvaluator_NewOpinion7", Evaluator{Tolerance: 1e-6, Normalisation: Renormalise}, [4]float64{0.5, 0.3, 0.2 + 1e-5, 0.5}, Opinion{}, true},
+		{"TestEvaluator_NewOpinion8", Evaluator{Tolerance: 1e-6, Normalisation: Renormalise}, [4]float64{0.6, 0.5, -0.1, 0.5}, Opinion{}, true},
+		{"TestEvaluator_NewOpinion9", Evaluator{Tolerance: 1e-6, Normalisation: Clamp}, [4]float64{1 + 1e-9, 0, -1e-9, 1 + 2e-6}, Opinion{1, 0, 0, 1}, false},
+		{"TestEvaluator_NewOpinion10", Evaluator{Normalisation: Clamp}, [4]float64{0.6, 0.5, -0.1, 0.5}, Opinion{}, true},
+		{"TestEvaluator_NewOpinion11", Evaluator{Normalisation: Clamp}, [4]float64{1, 0, 0, 1.5}, Opinion{}, true},
+		{"TestEvaluator_NewOpinion12", Evaluator{Tolerance: 1e-6, Normalisation: Clamp}, [4]float64{1 + 4e-6, 0, -4e-6, 0.5}, Opinion{}, true},
+		{"TestEvaluator_NewOpinion13", Evaluator{Normalisation: Clamp}, [4]float64{0.5, 0.5, 0, math.NaN()}, Opinion{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.evaluator.NewOpinion(tt.values[0], tt.values[1], tt.values[2], tt.values[3])
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewOpinion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidOpinion) {
+				t.Errorf("NewOpinion() error = %v, want %v", err, ErrInvalidOpinion)
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("NewOpinion() got = %v, want %v", &got, &tt.want)
+			}
+			if err == nil && tt.evaluator.Normalisation == Renormalise && math.Abs(1-(got.belief+got.disbelief+got.uncertainty)) > Precision {
+				t.Errorf("NewOpinion() got = %v, which is not normalised", &got)
+			}
+		})
+	}
+}
+
+func TestEvaluator_Operators(t *testing.T) {
+	// drifted deviates from b+d+u = 1 by more than Precision, as can happen at the end of a long trust chain
+	drifted := &Opinion{0.7, 0.2, 0.1 + 1e-9, 0.5}
+	valid := &Opinion{0.6, 0.3, 0.1, 0.5}
+
+	if _, err := Complement(drifted); !errors.Is(err, ErrInvalidOpinion) {
+		t.Errorf("Complement() error = %v, want %v", err, ErrInvalidOpinion)
+	}
+
+	e := Evaluator{Tolerance: 1e-6, Normalisation: Renormalise}
+	if got, err := e.Complement(drifted); err != nil || math.Abs(1-(got.belief+got.disbelief+got.uncertainty)) > Precision {
+		t.Errorf("Complement() got = %v, %v", &got, err)
+	}
+	ops := map[string]func(*Opinion, *Opinion) (Opinion, error){
+		"AveragingFusion":  e.AveragingFusion,
+		"CumulativeFusion": e.CumulativeFusion,
+		"TrustDiscounting": e.TrustDiscounting,
+		"WeightedFusion":   e.WeightedFusion,
+	}
+	for name, op := range ops {
+		got, err := op(drifted, valid)
+		if err != nil {
+			t.Errorf("%s() error = %v", name, err)
+			continue
+		}
+		if math.Abs(1-(got.belief+got.disbelief+got.uncertainty)) > Precision {
+			t.Errorf("%s() got = %v, which is not normalised", name, &got)
+		}
+	}
+
+	want, _ := CumulativeFusion(valid, valid)
+	got, err := Evaluator{Normalisation: Clamp}.CumulativeFusion(valid, valid)
+	if err != nil || got != want {
+		t.Errorf("CumulativeFusion() got = %v, %v, want %v", &got, err, &want)
+	}
+}
+
+func TestEvaluator_Compare(t *testing.T) {
+	o1 := Opinion{0.5, 0.3, 0.2, 0.5}
+	o2 := Opinion{0.5 + 1e-9, 0.3 - 1e-9, 0.2, 0.5}
+	if (Evaluator{}).Compare(o1, o2) || o1.Compare(o2) {
+		t.Errorf("Compare() = true with default tolerance")
+	}
+	if !(Evaluator{Tolerance: 1e-6}).Compare(o1, o2) {
+		t.Errorf("Compare() = false with tolerance 1e-6")
+	}
+}
@@ -0,0 +1,80 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+)
+
+/*
+PriorWeight is the non-informative prior weight W used to map between Opinions and evidence or Beta distributions.
+*/
+const PriorWeight float64 = 2
+
+/*
+NewOpinionFromEvidence takes the amount of positive evidence r, the amount of negative evidence s and a base rate,
+and outputs the corresponding *Opinion as well as an Error.
+The Opinion is formed as b = r/(r+s+W), d = s/(r+s+W), u = W/(r+s+W) with W = PriorWeight.
+If r or s is negative or not a number, or the base rate violates 0 <= a <= 1, a zeroed Opinion and an error are returned.
+*/
+func NewOpinionFromEvidence(r, s, baseRate float64) (Opinion, error) {
+	return NewOpinionFromEvidenceOf(r, s, baseRate)
+}
+
+/*
+NewOpinionFromEvidenceOf is the generic counterpart of NewOpinionFromEvidence.
+*/
+func NewOpinionFromEvidenceOf[T Float](r, s, baseRate T) (OpinionOf[T], error) {
+	if !(r >= 0) || !(s >= 0) {
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromEvidence", ErrInvalidOpinion, "Evidence must be non-negative")
+	}
+	if math.IsInf(float64(r), 1) || math.IsInf(float64(s), 1) {
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromEvidence", ErrInvalidOpinion, "Evidence must be finite")
+	}
+	w := T(PriorWeight)
+	sum := r + s + w
+	return newResult(Evaluator{}, "NewOpinionFromEvidence", r/sum, s/sum, w/sum, baseRate)
+}
+
+/*
+Evidence is called onto an *Opinion o and returns the amounts of positive evidence r and negative evidence s that o corresponds to.
+Dogmatic opinions, i.e. opinions with u = 0, correspond to an infinite amount of evidence for every non-zero belief mass.
+*/
+func (opinion *OpinionOf[T]) Evidence() (r, s T) {
+	if opinion == nil {
+		panic("Evidence(): method call from nil pointer")
+	}
+	return evidence(opinion.belief, opinion.uncertainty), evidence(opinion.disbelief, opinion.uncertainty)
+}
+
+/*
+BetaParameters is called onto an *Opinion o and returns the parameters alpha and beta of the Beta distribution o is equivalent to,
+i.e. alpha = r + W*a and beta = s + W*(1-a).
+*/
+func (opinion *OpinionOf[T]) BetaParameters() (alpha, beta T) {
+	r, s := opinion.Evidence()
+	w := T(PriorWeight)
+	return r + w*opinion.baseRate, s + w*(1-opinion.baseRate)
+}
+
+func evidence[T Float](mass, uncertainty T) T {
+	if mass == 0 {
+		return 0
+	}
+	if uncertainty == 0 {
+		return T(math.Inf(1))
+	}
+	return T(PriorWeight) * mass / uncertainty
+}
@@ -0,0 +1,106 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewOpinionFromEvidence(t *testing.T) {
+	type args struct {
+		r        float64
+		s        float64
+		baseRate float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Opinion
+		wantErr bool
+	}{
+		//invalid input
+		{"TestNewOpinionFromEvidence1", args{-1, 0, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromEvidence2", args{0, math.NaN(), 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromEvidence3", args{math.Inf(1), 0, 0.5}, Opinion{}, true},
+		{"TestNewOpinionFromEvidence4", args{1, 1, 1.5}, Opinion{}, true},
+
+		//general tests
+		{"TestNewOpinionFromEvidence5", args{0, 0, 0.5}, Opinion{0, 0, 1, 0.5}, false},
+		{"TestNewOpinionFromEvidence6", args{2, 0, 0.5}, Opinion{0.5, 0, 0.5, 0.5}, false},
+		{"TestNewOpinionFromEvidence7", args{6, 2, 0.2}, Opinion{0.6, 0.2, 0.2, 0.2}, false},
+		{"TestNewOpinionFromEvidence8", args{0.5, 1.5, 0.9}, Opinion{0.125, 0.375, 0.5, 0.9}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOpinionFromEvidence(tt.args.r, tt.args.s, tt.args.baseRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOpinionFromEvidence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("NewOpinionFromEvidence() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpinion_Evidence(t *testing.T) {
+	var o *Opinion
+
+	gotPanic := false
+	func() {
+		defer func() {
+			if err := recover(); err != nil {
+				gotPanic = true
+			}
+		}()
+		_, _ = o.Evidence()
+	}()
+	if !gotPanic {
+		t.Errorf("Invalid call from \"nil\" passed undetected")
+	}
+
+	tests := []struct {
+		opinion Opinion
+		r       float64
+		s       float64
+		alpha   float64
+		beta    float64
+	}{
+		{Opinion{0, 0, 1, 0.5}, 0, 0, 1, 1},
+		{Opinion{0.6, 0.2, 0.2, 0.2}, 6, 2, 6.4, 3.6},
+		{Opinion{0.125, 0.375, 0.5, 0.9}, 0.5, 1.5, 2.3, 1.7},
+		{Opinion{1, 0, 0, 0.5}, math.Inf(1), 0, math.Inf(1), 1},
+		{Opinion{0.4, 0.6, 0, 0.5}, math.Inf(1), math.Inf(1), math.Inf(1), math.Inf(1)},
+	}
+	for i, tt := range tests {
+		r, s := tt.opinion.Evidence()
+		alpha, beta := tt.opinion.BetaParameters()
+		if !closeTo(r, tt.r) || !closeTo(s, tt.s) {
+			t.Errorf("Evidence() on i = %d got = %v, %v, want %v, %v", i, r, s, tt.r, tt.s)
+		}
+		if !closeTo(alpha, tt.alpha) || !closeTo(beta, tt.beta) {
+			t.Errorf("BetaParameters() on i = %d got = %v, %v, want %v, %v", i, alpha, beta, tt.alpha, tt.beta)
+		}
+	}
+}
+
+func closeTo(x, y float64) bool {
+	if math.IsInf(x, 1) || math.IsInf(y, 1) {
+		return x == y
+	}
+	return math.Abs(x-y) < Precision
+}
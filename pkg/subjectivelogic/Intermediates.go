@@ -0,0 +1,58 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+/*
+Intermediates takes the name of an operator of NewStandardRegistry and the Opinions it is applied to, and returns the values
+the operator derives from the Opinions on the way to its result: "trust_probability", the projected probability of the trust Opinion,
+for "disc", "path_probability", the product of the projected probabilities of the trust path, for "disc_multi",
+the "harmony" and "conflict" of the Opinions for "fuse_con", and the "denominator" normalising the fused belief and uncertainty
+for "fuse_cum", "fuse_avg" and "fuse_wgt", unless the Opinions are a special case that is not normalised, e.g. two dogmatic Opinions.
+Other operators, unknown names and a wrong number of Opinions yield nil. The values are those of the operator's own computation,
+so they are returned even if the operator fails on the Opinions, e.g. a conflict of 1 for totally conflicting Opinions.
+*/
+func Intermediates(name string, opinions ...Opinion) map[string]float64 {
+	if name == "disc_multi" {
+		if len(opinions) < 2 {
+			return nil
+		}
+		return map[string]float64{"path_probability": pathProbability(opinions[:len(opinions)-1])}
+	}
+	if len(opinions) != 2 {
+		return nil
+	}
+	o1, o2 := opinions[0], opinions[1]
+	u1, u2 := o1.uncertainty, o2.uncertainty
+	switch name {
+	case "disc":
+		return map[string]float64{"trust_probability": trustProbability(o1)}
+	case "fuse_con":
+		har, con := harmonyConflict(o1, o2)
+		return map[string]float64{"harmony": har, "conflict": con}
+	case "fuse_cum":
+		if u1 != 0 || u2 != 0 {
+			return map[string]float64{"denominator": cumulativeFusionDenominator(u1, u2)}
+		}
+	case "fuse_avg":
+		if u1 != 0 || u2 != 0 {
+			return map[string]float64{"denominator": averagingFusionDenominator(u1, u2)}
+		}
+	case "fuse_wgt":
+		if (u1 != 0 || u2 != 0) && (u1 != 1 || u2 != 1) {
+			return map[string]float64{"denominator": weightedFusionDenominator(u1, u2)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestIntermediates(t *testing.T) {
+	x := Opinion{0.6, 0.3, 0.1, 0.5}
+	y := Opinion{0.2, 0.3, 0.5, 0.5}
+	belief := Opinion{1, 0, 0, 0.5}
+	disbelief := Opinion{0, 1, 0, 0.5}
+
+	tests := []struct {
+		name     string
+		operator string
+		opinions []Opinion
+		want     map[string]float64
+	}{
+		{"TestIntermediates1", "disc", []Opinion{x, y}, map[string]float64{"trust_probability": 0.65}},
+		{"TestIntermediates2", "disc_multi", []Opinion{x, x, y}, map[string]float64{"path_probability": 0.65 * 0.65}},
+		{"TestIntermediates3", "fuse_con", []Opinion{x, y}, map[string]float64{"harmony": 0.44, "conflict": 0.24}},
+		// the values are returned for opinions the operator fails on
+		{"TestIntermediates4", "fuse_con", []Opinion{belief, disbelief}, map[string]float64{"harmony": 0, "conflict": 1}},
+		{"TestIntermediates5", "fuse_cum", []Opinion{x, y}, map[string]float64{"denominator": 0.55}},
+		{"TestIntermediates6", "fuse_avg", []Opinion{x, y}, map[string]float64{"denominator": 0.6}},
+		{"TestIntermediates7", "fuse_wgt", []Opinion{x, y}, map[string]float64{"denominator": 0.5}},
+
+		// no intermediate values
+		{"TestIntermediates8", "fuse_cum", []Opinion{belief, disbelief}, nil},
+		{"TestIntermediates9", "fuse_wgt", []Opinion{{0, 0, 1, 0.5}, {0, 0, 1, 0.5}}, nil},
+		{"TestIntermediates10", "add", []Opinion{x, y}, nil},
+		{"TestIntermediates11", "unknown", []Opinion{x, y}, nil},
+		{"TestIntermediates12", "disc", []Opinion{x}, nil},
+		{"TestIntermediates13", "disc_multi", []Opinion{x}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Intermediates(tt.operator, tt.opinions...)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("Intermediates() got = %v, want nil", got)
+				}
+				return
+			}
+			if !reflect.DeepEqual(intermediateKeys(got), intermediateKeys(tt.want)) {
+				t.Fatalf("Intermediates() got = %v, want %v", got, tt.want)
+			}
+			for k, want := range tt.want {
+				if math.Abs(got[k]-want) > 1e-12 {
+					t.Errorf("Intermediates() got %s = %v, want %v", k, got[k], want)
+				}
+			}
+		})
+	}
+
+	// the result of constraint fusion is formed from its harmony and conflict
+	values := Intermediates("fuse_con", x, y)
+	fused, _ := ConstraintFusion(&x, &y)
+	if b := values["harmony"] / (1 - values["conflict"]); math.Abs(fused.Belief()-b) > 1e-12 {
+		t.Errorf("ConstraintFusion() got belief %v, want %v from the intermediate values", fused.Belief(), b)
+	}
+}
+
+func intermediateKeys(m map[string]float64) map[string]bool {
+	set := make(map[string]bool, len(m))
+	for k := range m {
+		set[k] = true
+	}
+	return set
+}
@@ -14,22 +14,56 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-)
-
 func Addition(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("Addition: Input cannot be nil")
+	return addition(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+AdditionOf is the generic counterpart of Addition.
+*/
+func AdditionOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return addition(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+Addition is called onto an Evaluator e and adds the Opinions o1 and o2 like the function Addition,
+normalising the result according to e.
+*/
+func (e Evaluator) Addition(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return addition(e, opinion1, opinion2)
+}
+
+func addition[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("Addition", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
 	}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("Addition: Inputs cannot be null opinions")
+	b, d, u, a, fail := additionValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("Addition", fail.err, fail.reason, opinion1, opinion2)
+	}
+
+	o, err := newResult(e, "Addition", b, d, u, a)
+
+	if err != nil {
+		return OpinionOf[T]{}, newOperatorError("Addition", ErrInvalidOpinion, "Check the validity of your input values", opinion1, opinion2)
+	}
+
+	if b > 1 {
+		return OpinionOf[T]{}, newOperatorError("Addition", ErrInvalidOpinion, "Sum of beliefs cannot exceed 1", opinion1, opinion2)
+	} else if a > 1 {
+		return OpinionOf[T]{}, newOperatorError("Addition", ErrInvalidOpinion, "Sum of base rates cannot exceed 1", opinion1, opinion2)
 	}
 
+	return o, err
+
+}
+
+/*
+additionValues computes the values of the Opinion resulting from Addition without validating inputs or result.
+*/
+func additionValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	b1 := opinion1.belief
 	d1 := opinion1.disbelief
 	u1 := opinion1.uncertainty
@@ -40,33 +74,14 @@ func Addition(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 	u2 := opinion2.uncertainty
 	a2 := opinion2.baseRate
 
-	b := -1.0
-	d := -1.0
-	u := -1.0
-	a := -1.0
-
 	if a1 == 0 && a2 == 0 {
-		return Opinion{}, errors.New("Addition: Base rates cannot be both equal to 0")
-
-	} else {
-		b = b1 + b2
-		d = (a1*(d1-b2) + a2*(d2-b1)) / (a1 + a2)
-		u = (a1*u1 + a2*u2) / (a1 + a2)
-		a = a1 + a2
+		return 0, 0, 0, 0, &failure{ErrUndefined, "Base rates cannot be both equal to 0"}
 	}
 
-	o, err := NewOpinion(b, d, u, a)
-
-	if err != nil {
-		return Opinion{}, errors.New("Addition: Check the validity of your input values")
-	}
-
-	if b > 1 {
-		return Opinion{}, errors.New("Addition: Sum of beliefs cannot exceed 1")
-	} else if a > 1 {
-		return Opinion{}, errors.New("Addition: Sum of base rates cannot exceed 1")
-	}
-
-	return o, err
+	b := b1 + b2
+	d := (a1*(d1-b2) + a2*(d2-b1)) / (a1 + a2)
+	u := (a1*u1 + a2*u2) / (a1 + a2)
+	a := a1 + a2
 
+	return b, d, u, a, nil
 }
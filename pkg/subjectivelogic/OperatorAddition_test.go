@@ -75,3 +75,7 @@ func TestAddition(t *testing.T) {
 func BenchmarkAddition(b *testing.B) {
 	bmBinarySlFunc(Addition, b)
 }
+
+func BenchmarkAdditionBatch(b *testing.B) {
+	bmBatchSlFunc(AdditionBatch, b)
+}
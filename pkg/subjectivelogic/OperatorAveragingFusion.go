@@ -14,23 +14,41 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-	"math"
-)
-
 func AveragingFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("AveragingFusion: Input cannot be nil")
-	}
+	return averagingFusion(Evaluator{}, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("AveragingFusion: Inputs cannot be null opinions")
+/*
+AveragingFusionOf is the generic counterpart of AveragingFusion.
+*/
+func AveragingFusionOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return averagingFusion(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+AveragingFusion is called onto an Evaluator e and fuses the Opinions o1 and o2 like the function AveragingFusion,
+normalising the result according to e.
+*/
+func (e Evaluator) AveragingFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return averagingFusion(e, opinion1, opinion2)
+}
+
+func averagingFusion[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("AveragingFusion", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
+	}
+	b, d, u, a, fail := averagingFusionValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("AveragingFusion", fail.err, fail.reason, opinion1, opinion2)
 	}
+	return newResult(e, "AveragingFusion", b, d, u, a, opinion1, opinion2)
+}
 
+/*
+averagingFusionValues computes the values of the Opinion resulting from AveragingFusion without validating inputs or result.
+*/
+func averagingFusionValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	b1 := opinion1.belief
 	u1 := opinion1.uncertainty
 	a1 := opinion1.baseRate
@@ -39,15 +57,16 @@ func AveragingFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 	u2 := opinion2.uncertainty
 	a2 := opinion2.baseRate
 
-	b := -1.0
-	d := -1.0
-	u := -1.0
-	a := -1.0
+	b := T(-1)
+	d := T(-1)
+	u := T(-1)
+	a := T(-1)
 
 	if u1 != 0 || u2 != 0 {
 
-		b = (b1*u2 + b2*u1) / (u1 + u2)
-		u = 2 * u1 * u2 / (u1 + u2)
+		k := averagingFusionDenominator(u1, u2)
+		b = (b1*u2 + b2*u1) / k
+		u = 2 * u1 * u2 / k
 		a = (a1 + a2) / 2
 
 	} else {
@@ -57,7 +76,15 @@ func AveragingFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 		a = 0.5 * (a1 + a2)
 	}
 
-	d = math.Max(0, 1-b-u)
+	d = max(0, 1-b-u)
+
+	return b, d, u, a, nil
+}
 
-	return NewOpinion(b, d, u, a)
+/*
+averagingFusionDenominator computes the denominator by which AveragingFusion normalises the fusion of Opinions
+with the uncertainties u1 and u2, unless both are 0.
+*/
+func averagingFusionDenominator[T Float](u1, u2 T) T {
+	return u1 + u2
 }
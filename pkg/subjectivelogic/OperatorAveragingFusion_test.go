@@ -102,3 +102,7 @@ func TestAveragingFusion(t *testing.T) {
 func BenchmarkAveragingFusion(b *testing.B) {
 	bmBinarySlFunc(AveragingFusion, b)
 }
+
+func BenchmarkAveragingFusionBatch(b *testing.B) {
+	bmBatchSlFunc(AveragingFusionBatch, b)
+}
@@ -14,14 +14,37 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-)
-
 func Complement(opinion *Opinion) (Opinion, error) {
+	return complement(Evaluator{}, opinion)
+}
+
+/*
+ComplementOf is the generic counterpart of Complement.
+*/
+func ComplementOf[T Float](opinion *OpinionOf[T]) (OpinionOf[T], error) {
+	return complement(Evaluator{}, opinion)
+}
+
+/*
+Complement is called onto an Evaluator e and returns the complement of the Opinion o like the function Complement,
+normalising the result according to e.
+*/
+func (e Evaluator) Complement(opinion *Opinion) (Opinion, error) {
+	return complement(e, opinion)
+}
+
+func complement[T Float](e Evaluator, opinion *OpinionOf[T]) (OpinionOf[T], error) {
 	if opinion == nil {
-		return Opinion{}, errors.New("Complement: Input cannot be nil")
+		return OpinionOf[T]{}, newOperatorError("Complement", ErrNilInput, "", opinion)
 	}
 
-	return NewOpinion(opinion.disbelief, opinion.belief, opinion.uncertainty, 1-opinion.baseRate)
+	b, d, u, a, _ := complementValues(*opinion)
+	return newResult(e, "Complement", b, d, u, a, opinion)
+}
+
+/*
+complementValues computes the values of the Opinion resulting from Complement without validating input or result.
+*/
+func complementValues[T Float](opinion OpinionOf[T]) (T, T, T, T, *failure) {
+	return opinion.disbelief, opinion.belief, opinion.uncertainty, 1 - opinion.baseRate, nil
 }
@@ -14,25 +14,44 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-)
-
 func Comultiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("Comultiplication: Input cannot be nil")
-	}
+	return comultiplication(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+ComultiplicationOf is the generic counterpart of Comultiplication.
+*/
+func ComultiplicationOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return comultiplication(Evaluator{}, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("Comultiplication: Inputs cannot be null opinions")
+/*
+Comultiplication is called onto an Evaluator e and comultiplies the Opinions o1 and o2 like the function Comultiplication,
+normalising the result according to e.
+*/
+func (e Evaluator) Comultiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return comultiplication(e, opinion1, opinion2)
+}
+
+func comultiplication[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("Comultiplication", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
 	}
+	b, d, u, a, fail := comultiplicationValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("Comultiplication", fail.err, fail.reason, opinion1, opinion2)
+	}
+	return newResult(e, "Comultiplication", b, d, u, a, opinion1, opinion2)
+}
 
+/*
+comultiplicationValues computes the values of the Opinion resulting from Comultiplication without validating inputs or result.
+*/
+func comultiplicationValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	// Checking if base rates are 0 as this is undefined
 	if opinion1.baseRate == 0 && opinion2.baseRate == 0 {
-		return Opinion{}, errors.New("Comultiplication: Invalid arguments: opinion1.baseRate = opinion2.baseRate = 0")
+		return 0, 0, 0, 0, &failure{ErrUndefined, "Invalid arguments: opinion1.baseRate = opinion2.baseRate = 0"}
 	}
 
 	b1 := opinion1.belief
@@ -50,5 +69,5 @@ func Comultiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 	u := u1*u2 + (a2*d1*u2+a1*u1*d2)/(a1+a2-a1*a2)
 	a := a1 + a2 - a1*a2
 
-	return NewOpinion(b, d, u, a)
+	return b, d, u, a, nil
 }
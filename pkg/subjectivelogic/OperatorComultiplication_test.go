@@ -124,3 +124,7 @@ func TestComultiplication(t *testing.T) {
 func BenchmarkComultiplication(b *testing.B) {
 	bmBinarySlFunc(Comultiplication, b)
 }
+
+func BenchmarkComultiplicationBatch(b *testing.B) {
+	bmBatchSlFunc(ComultiplicationBatch, b)
+}
@@ -14,49 +14,75 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-)
-
 func ConstraintFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("ConstraintFusion: Input cannot be nil")
-	}
+	return constraintFusion(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+ConstraintFusionOf is the generic counterpart of ConstraintFusion.
+*/
+func ConstraintFusionOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return constraintFusion(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+ConstraintFusion is called onto an Evaluator e and fuses the Opinions o1 and o2 like the function ConstraintFusion,
+normalising the result according to e.
+*/
+func (e Evaluator) ConstraintFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return constraintFusion(e, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("ConstraintFusion: Inputs cannot be null opinions")
+func constraintFusion[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("ConstraintFusion", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
+	}
+	b, d, u, a, fail := constraintFusionValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("ConstraintFusion", fail.err, fail.reason, opinion1, opinion2)
 	}
+	return newResult(e, "ConstraintFusion", b, d, u, a, opinion1, opinion2)
+}
 
-	b1 := opinion1.belief
-	d1 := opinion1.disbelief
+/*
+constraintFusionValues computes the values of the Opinion resulting from ConstraintFusion without validating inputs or result.
+*/
+func constraintFusionValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	u1 := opinion1.uncertainty
 	a1 := opinion1.baseRate
 
-	b2 := opinion2.belief
-	d2 := opinion2.disbelief
 	u2 := opinion2.uncertainty
 	a2 := opinion2.baseRate
 
-	har := b1*u2 + b2*u1 + b1*b2
-	con := b1*d2 + b2*d1
+	har, con := harmonyConflict(opinion1, opinion2)
 
 	if con == 1 {
-		return Opinion{}, errors.New("ConstraintFusion: mathematically possible only if input opinions are not conflicting and do not result in Con = 1")
+		return 0, 0, 0, 0, &failure{ErrTotalConflict, "mathematically possible only if input opinions are not conflicting and do not result in Con = 1"}
 	}
 
 	b := har / (1 - con)
 	u := u1 * u2 / (1 - con)
 	d := 1 - b - u
 
-	a := -1.0
+	a := T(-1)
 	if u1+u2 < 2 {
-		a = (a1*(1-u1) + a2*(1-u2)) / (2 - u1 - u2)
+		a = weightedMean(a1, 1-u1, a2, 1-u2)
 	} else {
 		a = (a1 + a2) / 2
 	}
 
-	return NewOpinion(b, d, u, a)
+	return b, d, u, a, nil
+}
+
+/*
+harmonyConflict computes the harmony and the conflict between two Opinions, from which ConstraintFusion forms its result.
+*/
+func harmonyConflict[T Float](opinion1, opinion2 OpinionOf[T]) (T, T) {
+	b1, d1, u1 := opinion1.belief, opinion1.disbelief, opinion1.uncertainty
+	b2, d2, u2 := opinion2.belief, opinion2.disbelief, opinion2.uncertainty
+
+	har := b1*u2 + b2*u1 + b1*b2
+	con := b1*d2 + b2*d1
+	return har, con
 }
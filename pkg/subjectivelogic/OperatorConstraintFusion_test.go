@@ -91,6 +91,12 @@ func TestConstraintFusion(t *testing.T) {
 			Opinion{0.3519188499188, 0.535653337338, 0.1124278127432, 0.7128099173554},
 			false,
 		},
+		// the expanded formula of the base rate yielded 1.0000000000000002
+		{"TestConstraintFusion12",
+			args{&Opinion{0.9, 0.09, 0.01, 1}, &Opinion{0.8, 0.12, 0.08, 1}},
+			Opinion{0.9756097560976, 0.02341463414634, 0.0009756097560976, 1},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -109,3 +115,7 @@ func TestConstraintFusion(t *testing.T) {
 func BenchmarkConstraintFusion(b *testing.B) {
 	bmBinarySlFunc(ConstraintFusion, b)
 }
+
+func BenchmarkConstraintFusionBatch(b *testing.B) {
+	bmBatchSlFunc(ConstraintFusionBatch, b)
+}
@@ -14,23 +14,41 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-	"math"
-)
-
 func CumulativeFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("CumulativeFusion: Input cannot be nil")
-	}
+	return cumulativeFusion(Evaluator{}, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("CumulativeFusion: Inputs cannot be null opinions")
+/*
+CumulativeFusionOf is the generic counterpart of CumulativeFusion.
+*/
+func CumulativeFusionOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return cumulativeFusion(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+CumulativeFusion is called onto an Evaluator e and fuses the Opinions o1 and o2 like the function CumulativeFusion,
+normalising the result according to e.
+*/
+func (e Evaluator) CumulativeFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return cumulativeFusion(e, opinion1, opinion2)
+}
+
+func cumulativeFusion[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("CumulativeFusion", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
+	}
+	b, d, u, a, fail := cumulativeFusionValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("CumulativeFusion", fail.err, fail.reason, opinion1, opinion2)
 	}
+	return newResult(e, "CumulativeFusion", b, d, u, a, opinion1, opinion2)
+}
 
+/*
+cumulativeFusionValues computes the values of the Opinion resulting from CumulativeFusion without validating inputs or result.
+*/
+func cumulativeFusionValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	b1 := opinion1.belief
 	u1 := opinion1.uncertainty
 	a1 := opinion1.baseRate
@@ -39,18 +57,19 @@ func CumulativeFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 	u2 := opinion2.uncertainty
 	a2 := opinion2.baseRate
 
-	b := -1.0
-	d := -1.0
-	u := -1.0
-	a := -1.0
+	b := T(-1)
+	d := T(-1)
+	u := T(-1)
+	a := T(-1)
 
 	if u1 != 0 || u2 != 0 {
 
-		b = (b1*u2 + b2*u1) / (u1 + u2 - u1*u2)
-		u = u1 * u2 / (u1 + u2 - u1*u2)
+		k := cumulativeFusionDenominator(u1, u2)
+		b = (b1*u2 + b2*u1) / k
+		u = u1 * u2 / k
 
 		if u1 != 1 || u2 != 1 {
-			a = (a1*u2 + a2*u1 - (a1+a2)*u1*u2) / (u1 + u2 - 2*u1*u2)
+			a = weightedMean(a1, u2*(1-u1), a2, u1*(1-u2))
 		} else {
 			a = (a1 + a2) / 2
 		}
@@ -63,7 +82,15 @@ func CumulativeFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 
 	}
 
-	d = math.Max(0, 1-b-u)
+	d = max(0, 1-b-u)
+
+	return b, d, u, a, nil
+}
 
-	return NewOpinion(b, d, u, a)
+/*
+cumulativeFusionDenominator computes the denominator by which CumulativeFusion normalises the fusion of Opinions
+with the uncertainties u1 and u2, unless both are 0.
+*/
+func cumulativeFusionDenominator[T Float](u1, u2 T) T {
+	return u1 + u2 - u1*u2
 }
@@ -96,6 +96,12 @@ func TestCumulativeFusion(t *testing.T) {
 			Opinion{.75, .0, .25, .5},
 			false,
 		},
+		// the expanded formula of the base rate yielded 1.000000000000001
+		{"TestCumulativeFusion13",
+			args{&Opinion{0.197, 0, 0.803, 1}, &Opinion{0, 0, 1, 0.337}},
+			Opinion{0.197, 0, 0.803, 1},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -114,3 +120,7 @@ func TestCumulativeFusion(t *testing.T) {
 func BenchmarkCumulativeFusion(b *testing.B) {
 	bmBinarySlFunc(CumulativeFusion, b)
 }
+
+func BenchmarkCumulativeFusionBatch(b *testing.B) {
+	bmBatchSlFunc(CumulativeFusionBatch, b)
+}
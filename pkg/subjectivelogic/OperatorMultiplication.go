@@ -14,25 +14,44 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-)
-
 func Multiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("Multiplication: Input cannot be nil")
-	}
+	return multiplication(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+MultiplicationOf is the generic counterpart of Multiplication.
+*/
+func MultiplicationOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return multiplication(Evaluator{}, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("Addition: Inputs cannot be null opinions")
+/*
+Multiplication is called onto an Evaluator e and multiplies the Opinions o1 and o2 like the function Multiplication,
+normalising the result according to e.
+*/
+func (e Evaluator) Multiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return multiplication(e, opinion1, opinion2)
+}
+
+func multiplication[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("Multiplication", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
 	}
+	b, d, u, a, fail := multiplicationValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("Multiplication", fail.err, fail.reason, opinion1, opinion2)
+	}
+	return newResult(e, "Multiplication", b, d, u, a, opinion1, opinion2)
+}
 
+/*
+multiplicationValues computes the values of the Opinion resulting from Multiplication without validating inputs or result.
+*/
+func multiplicationValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	// Checking if base rates are 1 as this is undefined
 	if opinion1.baseRate == 1 && opinion2.baseRate == 1 {
-		return Opinion{}, errors.New("Multiplication: Base rates cannot both be 1")
+		return 0, 0, 0, 0, &failure{ErrUndefined, "Base rates cannot both be 1"}
 	}
 
 	b1 := opinion1.belief
@@ -50,5 +69,5 @@ func Multiplication(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 	u := u1*u2 + ((1-a2)*b1*u2+(1-a1)*u1*b2)/(1-a1*a2)
 	a := a1 * a2
 
-	return NewOpinion(b, d, u, a)
+	return b, d, u, a, nil
 }
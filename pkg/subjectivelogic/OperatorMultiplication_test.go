@@ -132,3 +132,7 @@ func TestMultiplication(t *testing.T) {
 func BenchmarkMultiplication(b *testing.B) {
 	bmBinarySlFunc(Multiplication, b)
 }
+
+func BenchmarkMultiplicationBatch(b *testing.B) {
+	bmBatchSlFunc(MultiplicationBatch, b)
+}
@@ -14,53 +14,102 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-)
-
 func TrustDiscounting(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("OpTrustDisc: Input cannot be nil")
-	}
+	return trustDiscounting(Evaluator{}, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("Addition: Inputs cannot be null opinions")
-	}
+/*
+TrustDiscountingOf is the generic counterpart of TrustDiscounting.
+*/
+func TrustDiscountingOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return trustDiscounting(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+TrustDiscounting is called onto an Evaluator e and discounts the Opinion o2 by the trust Opinion o1 like the function TrustDiscounting,
+normalising the result according to e.
+*/
+func (e Evaluator) TrustDiscounting(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return trustDiscounting(e, opinion1, opinion2)
+}
 
-	b1 := opinion1.belief
-	u1 := opinion1.uncertainty
-	a1 := opinion1.baseRate
+func trustDiscounting[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("TrustDiscounting", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
+	}
+	b, d, u, a, fail := trustDiscountingValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("TrustDiscounting", fail.err, fail.reason, opinion1, opinion2)
+	}
+	return newResult(e, "TrustDiscounting", b, d, u, a, opinion1, opinion2)
+}
 
+/*
+trustDiscountingValues computes the values of the Opinion resulting from TrustDiscounting without validating inputs or result.
+*/
+func trustDiscountingValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	b2 := opinion2.belief
 	d2 := opinion2.disbelief
 	a2 := opinion2.baseRate
 
-	p1 := b1 + u1*a1
+	p1 := trustProbability(opinion1)
 	b := p1 * b2
 	d := p1 * d2
 	u := 1 - b - d
 	a := a2
 
-	return NewOpinion(b, d, u, a)
+	return b, d, u, a, nil
+}
+
+/*
+trustProbability computes the projected probability of the trust Opinion by which TrustDiscounting discounts.
+*/
+func trustProbability[T Float](trust OpinionOf[T]) T {
+	return trust.belief + trust.uncertainty*trust.baseRate
+}
+
+/*
+pathProbability computes the product of the projected probabilities of the trust Opinions of a path, by which MultiEdgeTrustDisc discounts.
+*/
+func pathProbability[T Float](path []OpinionOf[T]) T {
+	p := T(1)
+	for i := range path {
+		p *= path[i].ProjectedProbability()
+	}
+	return p
 }
 
 func MultiEdgeTrustDisc(opinions []Opinion) (Opinion, error) {
+	return multiEdgeTrustDisc(Evaluator{}, opinions)
+}
+
+/*
+MultiEdgeTrustDiscOf is the generic counterpart of MultiEdgeTrustDisc.
+*/
+func MultiEdgeTrustDiscOf[T Float](opinions []OpinionOf[T]) (OpinionOf[T], error) {
+	return multiEdgeTrustDisc(Evaluator{}, opinions)
+}
+
+/*
+MultiEdgeTrustDisc is called onto an Evaluator e and discounts the last of the Opinions along the trust path formed by the others like the function MultiEdgeTrustDisc,
+normalising the result according to e.
+*/
+func (e Evaluator) MultiEdgeTrustDisc(opinions []Opinion) (Opinion, error) {
+	return multiEdgeTrustDisc(e, opinions)
+}
+
+func multiEdgeTrustDisc[T Float](e Evaluator, opinions []OpinionOf[T]) (OpinionOf[T], error) {
 
 	if opinions == nil {
-		return Opinion{}, errors.New("MultiEdgeTrustDisc: Input cannot be nil")
+		return OpinionOf[T]{}, newOperatorError[T]("MultiEdgeTrustDisc", ErrNilInput, "")
 	}
 	n := len(opinions)
 	if n < 2 {
-		return Opinion{}, errors.New("MultiEdgeTrustDisc: At least two Opinions required")
+		return OpinionOf[T]{}, newOperatorError[T]("MultiEdgeTrustDisc", ErrUndefined, "At least two Opinions required")
 	}
 
-	P_acc := 1.0
-	for i := 0; i < (n - 1); i++ {
-		P_acc *= opinions[i].ProjectedProbability()
-	}
+	P_acc := pathProbability(opinions[:n-1])
 
 	nth_Opinion := opinions[n-1]
 	b := P_acc * nth_Opinion.belief
@@ -68,5 +117,13 @@ func MultiEdgeTrustDisc(opinions []Opinion) (Opinion, error) {
 	u := 1 - b - d
 	a := nth_Opinion.baseRate
 
-	return NewOpinion(b, d, u, a)
+	o, err := newResult(e, "MultiEdgeTrustDisc", b, d, u, a)
+	if err != nil {
+		inputs := make([]*OpinionOf[T], n)
+		for i := range opinions {
+			inputs[i] = &opinions[i]
+		}
+		return OpinionOf[T]{}, newOperatorError("MultiEdgeTrustDisc", ErrInvalidOpinion, "Result is not a valid opinion", inputs...)
+	}
+	return o, nil
 }
@@ -14,22 +14,41 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-)
-
 func TrustDiscountingOppositeBelief(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("OpTrustDisc: Input cannot be nil")
-	}
+	return trustDiscountingOppositeBelief(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+TrustDiscountingOppositeBeliefOf is the generic counterpart of TrustDiscountingOppositeBelief.
+*/
+func TrustDiscountingOppositeBeliefOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return trustDiscountingOppositeBelief(Evaluator{}, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("Addition: Inputs cannot be null opinions")
+/*
+TrustDiscountingOppositeBelief is called onto an Evaluator e and discounts the Opinion o2 by the trust Opinion o1 like the function TrustDiscountingOppositeBelief,
+normalising the result according to e.
+*/
+func (e Evaluator) TrustDiscountingOppositeBelief(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return trustDiscountingOppositeBelief(e, opinion1, opinion2)
+}
+
+func trustDiscountingOppositeBelief[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("TrustDiscountingOppositeBelief", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
 	}
+	b, d, u, a, fail := trustDiscountingOppositeBeliefValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("TrustDiscountingOppositeBelief", fail.err, fail.reason, opinion1, opinion2)
+	}
+	return newResult(e, "TrustDiscountingOppositeBelief", b, d, u, a, opinion1, opinion2)
+}
 
+/*
+trustDiscountingOppositeBeliefValues computes the values of the Opinion resulting from TrustDiscountingOppositeBelief without validating inputs or result.
+*/
+func trustDiscountingOppositeBeliefValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	b1 := opinion1.belief
 	d1 := opinion1.disbelief
 	u1 := opinion1.uncertainty
@@ -44,5 +63,5 @@ func TrustDiscountingOppositeBelief(opinion1 *Opinion, opinion2 *Opinion) (Opini
 	u := u1 + (b1+d1)*u2
 	a := a2
 
-	return NewOpinion(b, d, u, a)
+	return b, d, u, a, nil
 }
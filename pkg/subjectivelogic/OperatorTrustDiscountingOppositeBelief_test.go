@@ -94,3 +94,7 @@ func TestTrustDiscountingOB(t *testing.T) {
 func BenchmarkTrustDiscountingOB(b *testing.B) {
 	bmBinarySlFunc(TrustDiscountingOppositeBelief, b)
 }
+
+func BenchmarkTrustDiscountingOBBatch(b *testing.B) {
+	bmBatchSlFunc(TrustDiscountingOppositeBeliefBatch, b)
+}
@@ -0,0 +1,67 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+func TrustDiscountingUncertaintyFavouring(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return trustDiscountingUncertaintyFavouring(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+TrustDiscountingUncertaintyFavouringOf is the generic counterpart of TrustDiscountingUncertaintyFavouring.
+*/
+func TrustDiscountingUncertaintyFavouringOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return trustDiscountingUncertaintyFavouring(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+TrustDiscountingUncertaintyFavouring is called onto an Evaluator e and discounts the Opinion o2 by the trust Opinion o1 like the function TrustDiscountingUncertaintyFavouring,
+normalising the result according to e.
+*/
+func (e Evaluator) TrustDiscountingUncertaintyFavouring(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return trustDiscountingUncertaintyFavouring(e, opinion1, opinion2)
+}
+
+func trustDiscountingUncertaintyFavouring[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("TrustDiscountingUncertaintyFavouring", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
+	}
+	b, d, u, a, fail := trustDiscountingUncertaintyFavouringValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("TrustDiscountingUncertaintyFavouring", fail.err, fail.reason, opinion1, opinion2)
+	}
+	return newResult(e, "TrustDiscountingUncertaintyFavouring", b, d, u, a, opinion1, opinion2)
+}
+
+/*
+trustDiscountingUncertaintyFavouringValues computes the values of the Opinion resulting from TrustDiscountingUncertaintyFavouring without validating inputs or result.
+*/
+func trustDiscountingUncertaintyFavouringValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
+	b1 := opinion1.belief
+	d1 := opinion1.disbelief
+	u1 := opinion1.uncertainty
+
+	b2 := opinion2.belief
+	d2 := opinion2.disbelief
+	u2 := opinion2.uncertainty
+	a2 := opinion2.baseRate
+
+	b := b1 * b2
+	d := b1 * d2
+	u := d1 + u1 + b1*u2
+	a := a2
+
+	return b, d, u, a, nil
+}
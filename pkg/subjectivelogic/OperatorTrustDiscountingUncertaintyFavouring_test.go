@@ -0,0 +1,92 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"testing"
+)
+
+func TestTrustDiscountingUF(t *testing.T) {
+	type args struct {
+		opinion1 *Opinion
+		opinion2 *Opinion
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Opinion
+		wantErr bool
+	}{
+		//nil input
+		{"TestTrustDiscountingUF1",
+			args{nil, nil},
+			Opinion{},
+			true},
+		{"TestTrustDiscountingUF2",
+			args{nil, &Opinion{1, 0, 0, 0.5}},
+			Opinion{},
+			true,
+		},
+		{"TestTrustDiscountingUF3",
+			args{&Opinion{1, 0, 0, 0.5}, &Opinion{0, 0, 0, 0}},
+			Opinion{},
+			true,
+		},
+
+		//general testing
+		{"TestTrustDiscountingUF4",
+			args{&Opinion{0, 0, 1, 0.5}, &Opinion{1, 0, 0, 0.5}},
+			Opinion{0, 0, 1, 0.5},
+			false,
+		},
+		{"TestTrustDiscountingUF5",
+			args{&Opinion{1, 0, 0, 0.5}, &Opinion{0.2, 0.3, 0.5, 0.4}},
+			Opinion{0.2, 0.3, 0.5, 0.4},
+			false,
+		},
+		// distrust only adds uncertainty, unlike TrustDiscountingOppositeBelief
+		{"TestTrustDiscountingUF6",
+			args{&Opinion{0, 1, 0, 0.5}, &Opinion{1, 0, 0, 0.5}},
+			Opinion{0, 0, 1, 0.5},
+			false,
+		},
+		// the base rate of the trust opinion is ignored, unlike TrustDiscounting
+		{"TestTrustDiscountingUF7",
+			args{&Opinion{0.6, 0.1, 0.3, 1}, &Opinion{0.5, 0.3, 0.2, 0.5}},
+			Opinion{0.3, 0.18, 0.52, 0.5},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TrustDiscountingUncertaintyFavouring(tt.args.opinion1, tt.args.opinion2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TrustDiscountingUncertaintyFavouring() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("TrustDiscountingUncertaintyFavouring() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkTrustDiscountingUF(b *testing.B) {
+	bmBinarySlFunc(TrustDiscountingUncertaintyFavouring, b)
+}
+
+func BenchmarkTrustDiscountingUFBatch(b *testing.B) {
+	bmBatchSlFunc(TrustDiscountingUncertaintyFavouringBatch, b)
+}
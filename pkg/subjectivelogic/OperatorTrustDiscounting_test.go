@@ -209,3 +209,13 @@ func TestMultiEdgeTrustDisc(t *testing.T) {
 func BenchmarkTrustDiscounting(b *testing.B) {
 	bmBinarySlFunc(TrustDiscounting, b)
 }
+
+func BenchmarkTrustDiscountingBatch(b *testing.B) {
+	bmBatchSlFunc(TrustDiscountingBatch, b)
+}
+
+func BenchmarkMultiEdgeTrustDiscBatch(b *testing.B) {
+	bmBatchSlFunc(func(dst, x, y Opinions) error {
+		return MultiEdgeTrustDiscBatch(dst, []Opinions{x, x, y})
+	}, b)
+}
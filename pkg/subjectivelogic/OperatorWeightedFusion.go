@@ -14,23 +14,41 @@
 
 package subjectivelogic
 
-import (
-	"errors"
-	"math"
-)
-
 func WeightedFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
-	// Checking if the opinion pointers are empty
-	if opinion1 == nil || opinion2 == nil {
-		return Opinion{}, errors.New("WeightedFusion: Input cannot be nil")
-	}
+	return weightedFusion(Evaluator{}, opinion1, opinion2)
+}
 
-	// Checking if the opinion values are null values
-	nullChecker := Opinion{belief: 0, disbelief: 0, uncertainty: 0, baseRate: 0}
-	if *opinion1 == nullChecker || *opinion2 == nullChecker {
-		return Opinion{}, errors.New("WeightedFusion: Inputs cannot be null opinions")
+/*
+WeightedFusionOf is the generic counterpart of WeightedFusion.
+*/
+func WeightedFusionOf[T Float](opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	return weightedFusion(Evaluator{}, opinion1, opinion2)
+}
+
+/*
+WeightedFusion is called onto an Evaluator e and fuses the Opinions o1 and o2 like the function WeightedFusion,
+normalising the result according to e.
+*/
+func (e Evaluator) WeightedFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return weightedFusion(e, opinion1, opinion2)
+}
+
+func weightedFusion[T Float](e Evaluator, opinion1 *OpinionOf[T], opinion2 *OpinionOf[T]) (OpinionOf[T], error) {
+	// Checking if the opinion pointers are empty or the opinion values are null values
+	if err := checkOperands("WeightedFusion", opinion1, opinion2); err != nil {
+		return OpinionOf[T]{}, err
+	}
+	b, d, u, a, fail := weightedFusionValues(*opinion1, *opinion2)
+	if fail != nil {
+		return OpinionOf[T]{}, newOperatorError("WeightedFusion", fail.err, fail.reason, opinion1, opinion2)
 	}
+	return newResult(e, "WeightedFusion", b, d, u, a, opinion1, opinion2)
+}
 
+/*
+weightedFusionValues computes the values of the Opinion resulting from WeightedFusion without validating inputs or result.
+*/
+func weightedFusionValues[T Float](opinion1, opinion2 OpinionOf[T]) (T, T, T, T, *failure) {
 	b1 := opinion1.belief
 	u1 := opinion1.uncertainty
 	a1 := opinion1.baseRate
@@ -39,16 +57,17 @@ func WeightedFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 	u2 := opinion2.uncertainty
 	a2 := opinion2.baseRate
 
-	b := -1.0
-	d := -1.0
-	u := -1.0
-	a := -1.0
+	b := T(-1)
+	d := T(-1)
+	u := T(-1)
+	a := T(-1)
 
 	if (u1 != 0 || u2 != 0) && (u1 != 1 || u2 != 1) {
 
-		b = (b1*(1-u1)*u2 + b2*(1-u2)*u1) / (u1 + u2 - 2*u1*u2)
-		u = (2 - u1 - u2) * u1 * u2 / (u1 + u2 - 2*u1*u2)
-		a = (a1*(1-u1) + a2*(1-u2)) / (2 - u1 - u2)
+		k := weightedFusionDenominator(u1, u2)
+		b = (b1*(1-u1)*u2 + b2*(1-u2)*u1) / k
+		u = (2 - u1 - u2) * u1 * u2 / k
+		a = weightedMean(a1, 1-u1, a2, 1-u2)
 
 	} else if u1 == 0 && u2 == 0 {
 
@@ -64,7 +83,15 @@ func WeightedFusion(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
 
 	}
 
-	d = math.Max(0, 1-b-u)
+	d = max(0, 1-b-u)
+
+	return b, d, u, a, nil
+}
 
-	return NewOpinion(b, d, u, a)
+/*
+weightedFusionDenominator computes the denominator by which WeightedFusion normalises the fusion of Opinions
+with the uncertainties u1 and u2, unless both are 0 or both are 1.
+*/
+func weightedFusionDenominator[T Float](u1, u2 T) T {
+	return u1 + u2 - 2*u1*u2
 }
@@ -91,6 +91,12 @@ func TestWeightedFusion(t *testing.T) {
 			Opinion{0.3445420741927, 0.3862656902719, 0.2691922355354, 0.7128099173554},
 			false,
 		},
+		// the expanded formula of the base rate yielded 1.0000000000000002
+		{"TestWeightedFusion12",
+			args{&Opinion{0.99, 0, 0.01, 1}, &Opinion{0.92, 0, 0.08, 1}},
+			Opinion{0.9827149321267, 0, 0.0172850678733, 1},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -109,3 +115,7 @@ func TestWeightedFusion(t *testing.T) {
 func BenchmarkWeightedFusion(b *testing.B) {
 	bmBinarySlFunc(WeightedFusion, b)
 }
+
+func BenchmarkWeightedFusionBatch(b *testing.B) {
+	bmBatchSlFunc(WeightedFusionBatch, b)
+}
@@ -16,26 +16,47 @@ package subjectivelogic
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"math"
 )
 
 /*
 Precision defines the maximum deviance each value of an Opinion can have for the Opinion to still be regarded as a valid Binomial Opinion.
+It is the default tolerance of an Evaluator.
 */
 const Precision float64 = 0.000000000001
 
+/*
+Precision32 is the counterpart of Precision for opinions of type OpinionOf[float32].
+*/
+const Precision32 float64 = 0.000001
+
+/*
+Float is the constraint for the value type of an OpinionOf.
+*/
+type Float interface {
+	float32 | float64
+}
+
 /*
 Opinion represents a Binomial Opinion from Subjective Logic.
 It is recommended to only generate new opinions using the NewOpinions function, as this will ensure the generated Opinion to be a valid Binomial Opinion,
 which the Operators in this library are designed to work with.
 */
-type Opinion struct {
-	belief      float64
-	disbelief   float64
-	uncertainty float64
-	baseRate    float64
+type Opinion = OpinionOf[float64]
+
+/*
+OpinionOf represents a Binomial Opinion from Subjective Logic with values of type T.
+Every function of this package has a generic counterpart with the suffix Of, e.g. NewOpinionOf or CumulativeFusionOf,
+which runs the same formulas on opinions of either precision.
+Opinions of type OpinionOf[float32] are validated with Precision32 instead of Precision,
+and values that lie less than Precision32 outside of [0, 1] are clamped to the interval.
+*/
+type OpinionOf[T Float] struct {
+	belief      T
+	disbelief   T
+	uncertainty T
+	baseRate    T
 }
 
 /*
@@ -57,38 +78,45 @@ If the input Values violate the requirements for a valid Opinion, the *Opinion w
 For a valid Opinion, all input values i must fulfill 0 <= i <= 1 and for the first tree inputs b, d, u, the statement b+d+u = 1 must hold.
 */
 func NewOpinion(belief, disbelief, uncertainty, baseRate float64) (Opinion, error) {
+	return NewOpinionOf(belief, disbelief, uncertainty, baseRate)
+}
+
+/*
+NewOpinionOf is the generic counterpart of NewOpinion.
+*/
+func NewOpinionOf[T Float](belief, disbelief, uncertainty, baseRate T) (OpinionOf[T], error) {
 	if !checkInput(belief, disbelief, uncertainty, baseRate) {
-		return Opinion{}, errors.New("NewOpinion: Invalid Input")
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinion", ErrInvalidOpinion, "")
 	}
-	op := Opinion{belief: belief, disbelief: disbelief, uncertainty: uncertainty, baseRate: baseRate}
+	op := OpinionOf[T]{belief: belief, disbelief: disbelief, uncertainty: uncertainty, baseRate: baseRate}
 	return op, nil
 }
 
 /*
 Belief is called onto an *Opinion o and returns o.belief.
 */
-func (opinion *Opinion) Belief() float64 {
+func (opinion *OpinionOf[T]) Belief() T {
 	return opinion.belief
 }
 
 /*
 Disbelief is called onto an *Opinion o and returns o.disbelief.
 */
-func (opinion *Opinion) Disbelief() float64 {
+func (opinion *OpinionOf[T]) Disbelief() T {
 	return opinion.disbelief
 }
 
 /*
 Uncertainty is called onto an *Opinion o and returns o.uncertainty.
 */
-func (opinion *Opinion) Uncertainty() float64 {
+func (opinion *OpinionOf[T]) Uncertainty() T {
 	return opinion.uncertainty
 }
 
 /*
 BaseRate is called onto an *Opinion o and returns o.baseRate.
 */
-func (opinion *Opinion) BaseRate() float64 {
+func (opinion *OpinionOf[T]) BaseRate() T {
 	if opinion == nil {
 		panic("BaseRate(): method call from nil pointer")
 	}
@@ -101,12 +129,12 @@ If the input values form a valid Opinion, the values of o will be changed to the
 If o is nil or the input values do not form a valid Opinion, o is left unchanged and an error is returned.
 For a valid Opinion, all input values i must fulfill 0 <= i <= 1 and for the first tree inputs b, d, u, the statement b+d+u = 1 must hold.
 */
-func (opinion *Opinion) Modify(belief, disbelief, uncertainty, baseRate float64) error {
+func (opinion *OpinionOf[T]) Modify(belief, disbelief, uncertainty, baseRate T) error {
 	if !checkInput(belief, disbelief, uncertainty, baseRate) {
-		return errors.New("Modify: Invalid Input")
+		return newOperatorError[T]("Modify", ErrInvalidOpinion, "")
 	}
 	if opinion == nil {
-		return errors.New("Modify: opinion is nil")
+		return newOperatorError[T]("Modify", ErrNilInput, "")
 	}
 	opinion.belief = belief
 	opinion.disbelief = disbelief
@@ -119,7 +147,7 @@ func (opinion *Opinion) Modify(belief, disbelief, uncertainty, baseRate float64)
 /*
 ProjectedProbability is called onto an *Opinion o and calculates the projected probability of o.
 */
-func (opinion *Opinion) ProjectedProbability() float64 {
+func (opinion *OpinionOf[T]) ProjectedProbability() T {
 	if opinion == nil {
 		panic("ProjectedProbability(): method call from nil pointer")
 	}
@@ -131,26 +159,23 @@ Compare is called onto an Opinion o1 and compares it with the input Opinion o2.
 If the values of o1 and o2 each match with a maximum difference of Precision, true is returned.
 Otherwise, false is returned.
 */
-func (opinion1 Opinion) Compare(opinion2 Opinion) bool {
-	return math.Abs(opinion1.belief-opinion2.belief) < Precision &&
-		math.Abs(opinion1.disbelief-opinion2.disbelief) < Precision &&
-		math.Abs(opinion1.uncertainty-opinion2.uncertainty) < Precision &&
-		math.Abs(opinion1.baseRate-opinion2.baseRate) < Precision
+func (opinion1 OpinionOf[T]) Compare(opinion2 OpinionOf[T]) bool {
+	return compare(Evaluator{}, opinion1, opinion2)
 }
 
 /*
 Copy is called onto an *Opinion o1 and returns a new *Opinion o2 that has the same values as o1.
 */
-func (opinion1 *Opinion) Copy() *Opinion {
+func (opinion1 *OpinionOf[T]) Copy() *OpinionOf[T] {
 
-	return &Opinion{opinion1.belief, opinion1.disbelief, opinion1.uncertainty, opinion1.baseRate}
+	return &OpinionOf[T]{opinion1.belief, opinion1.disbelief, opinion1.uncertainty, opinion1.baseRate}
 }
 
 /*
 String is called onto an *Opinion o and returns a string containing the values of o.
 If o is nil, "nil" is returned.
 */
-func (opinion *Opinion) String() string {
+func (opinion *OpinionOf[T]) String() string {
 	if opinion == nil {
 		return "nil"
 	}
@@ -162,8 +187,17 @@ func (opinion *Opinion) String() string {
 checkInput takes four float64 values as input and returns true, if they form a valid Opinion.
 Otherwise, false is returned.
 */
-func checkInput(b, d, u, a float64) bool {
-	if math.Abs(1-(b+d+u)) < 3*Precision &&
+func checkInput[T Float](b, d, u, a T) bool {
+	return checkTolerance(b, d, u, a, precision[T]())
+}
+
+/*
+checkTolerance takes four float64 values and a tolerance as input and returns true, if they form a valid Opinion,
+i.e. if all values lie in [0, 1] and b+d+u deviates less than 3*tolerance from 1.
+Otherwise, false is returned.
+*/
+func checkTolerance[T Float](b, d, u, a T, tolerance float64) bool {
+	if math.Abs(1-float64(b+d+u)) < 3*tolerance &&
 		0 <= b && b <= 1 &&
 		0 <= d && d <= 1 &&
 		0 <= u && u <= 1 &&
@@ -173,16 +207,59 @@ func checkInput(b, d, u, a float64) bool {
 	return false
 }
 
-func (opinion *Opinion) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		Belief      float64 `json:"belief"`
-		Disbelief   float64 `json:"disbelief"`
-		Uncertainty float64 `json:"uncertainty"`
-		BaseRate    float64 `json:"base_rate"`
-	}{
-		Belief:      opinion.Belief(),
-		Disbelief:   opinion.Disbelief(),
-		Uncertainty: opinion.Uncertainty(),
-		BaseRate:    opinion.BaseRate(),
+/*
+opinionJSON is the JSON representation of an Opinion.
+*/
+type opinionJSON struct {
+	Belief      float64 `json:"belief"`
+	Disbelief   float64 `json:"disbelief"`
+	Uncertainty float64 `json:"uncertainty"`
+	BaseRate    float64 `json:"base_rate"`
+}
+
+func (opinion *OpinionOf[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(opinionJSON{
+		Belief:      float64(opinion.Belief()),
+		Disbelief:   float64(opinion.Disbelief()),
+		Uncertainty: float64(opinion.Uncertainty()),
+		BaseRate:    float64(opinion.BaseRate()),
 	})
 }
+
+/*
+UnmarshalJSON is called onto an *Opinion o and sets the values of o to those of the JSON object in data.
+If the values do not form a valid Opinion, o is left unchanged and an error is returned.
+*/
+func (opinion *OpinionOf[T]) UnmarshalJSON(data []byte) error {
+	var v opinionJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	b, d, u, a := T(v.Belief), T(v.Disbelief), T(v.Uncertainty), T(v.BaseRate)
+	if !checkInput(b, d, u, a) {
+		return newOperatorError[T]("UnmarshalJSON", ErrInvalidOpinion, "")
+	}
+	opinion.belief = b
+	opinion.disbelief = d
+	opinion.uncertainty = u
+	opinion.baseRate = a
+	return nil
+}
+
+/*
+weightedMean returns the mean of the base rates a1 and a2 weighted by w1 and w2, e.g. (a1*u2 + a2*u1 - (a1+a2)*u1*u2) / (u1 + u2 - 2*u1*u2)
+for the weights u2*(1-u1) and u1*(1-u2). Unlike the expanded formulas, the result cannot be rounded out of [0, 1].
+*/
+func weightedMean[T Float](a1, w1, a2, w2 T) T {
+	return (a1*w1 + a2*w2) / (w1 + w2)
+}
+
+/*
+precision returns the default tolerance for opinions with values of type T.
+*/
+func precision[T Float]() float64 {
+	if _, ok := any(T(0)).(float32); ok {
+		return Precision32
+	}
+	return Precision
+}
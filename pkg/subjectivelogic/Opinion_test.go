@@ -15,6 +15,9 @@
 package subjectivelogic
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"math/rand"
 	"strconv"
@@ -353,6 +356,83 @@ func TestOpinion_ToString(t *testing.T) {
 	}
 }
 
+func TestOpinion_JSON(t *testing.T) {
+	o := &Opinion{testValuesOpinions[5][0], testValuesOpinions[5][1], testValuesOpinions[5][2], testValuesOpinions[5][3]}
+	data, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"belief":0.53,"disbelief":0.227,"uncertainty":0.243,"base_rate":1}`
+	if string(data) != expected {
+		t.Errorf("Icorrect output | Output: %v | Expected: %v", string(data), expected)
+	}
+
+	var got Opinion
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Errorf("False negative | Error: %s | Values: %s", err, data)
+	}
+	if !got.Compare(*o) {
+		t.Errorf("Incorrect output | Output: %v | Expected: %v", &got, o)
+	}
+
+	for i := nrOfValidOpinions; i < len(testValuesOpinions); i++ {
+		data := []byte(fmt.Sprintf(`{"belief":%v,"disbelief":%v,"uncertainty":%v,"base_rate":%v}`,
+			testValuesOpinions[i][0], testValuesOpinions[i][1], testValuesOpinions[i][2], testValuesOpinions[i][3]))
+		if err := json.Unmarshal(data, &got); err == nil {
+			t.Errorf("False positive on i = %d | Values: %s", i, data)
+		}
+	}
+}
+
+func TestOpinionOf(t *testing.T) {
+	if _, err := NewOpinionOf[float32](0.7, 0.2, 0.1, 0.5); err != nil {
+		t.Errorf("False negative | Error: %s", err)
+	}
+	if _, err := NewOpinionOf[float32](0.5, 0.5, 0.5, 0.5); !errors.Is(err, ErrInvalidOpinion) {
+		t.Errorf("False positive | Error: %v", err)
+	}
+
+	type binary struct {
+		f32 func(*OpinionOf[float32], *OpinionOf[float32]) (OpinionOf[float32], error)
+		f64 func(*Opinion, *Opinion) (Opinion, error)
+	}
+	operators := map[string]binary{
+		"Addition":                       {AdditionOf[float32], Addition},
+		"AveragingFusion":                {AveragingFusionOf[float32], AveragingFusion},
+		"Comultiplication":               {ComultiplicationOf[float32], Comultiplication},
+		"ConstraintFusion":               {ConstraintFusionOf[float32], ConstraintFusion},
+		"CumulativeFusion":               {CumulativeFusionOf[float32], CumulativeFusion},
+		"Multiplication":                 {MultiplicationOf[float32], Multiplication},
+		"TrustDiscounting":               {TrustDiscountingOf[float32], TrustDiscounting},
+		"TrustDiscountingOppositeBelief": {TrustDiscountingOppositeBeliefOf[float32], TrustDiscountingOppositeBelief},
+		"WeightedFusion":                 {WeightedFusionOf[float32], WeightedFusion},
+	}
+	for name, op := range operators {
+		for i := 0; i < nrOfValidOpinions; i++ {
+			for j := 0; j < nrOfValidOpinions; j++ {
+				v1, v2 := testValuesOpinions[i], testValuesOpinions[j]
+				o1, o2 := Opinion{v1[0], v1[1], v1[2], v1[3]}, Opinion{v2[0], v2[1], v2[2], v2[3]}
+				p1 := OpinionOf[float32]{float32(v1[0]), float32(v1[1]), float32(v1[2]), float32(v1[3])}
+				p2 := OpinionOf[float32]{float32(v2[0]), float32(v2[1]), float32(v2[2]), float32(v2[3])}
+
+				want, err64 := op.f64(&o1, &o2)
+				got, err32 := op.f32(&p1, &p2)
+				if err64 != nil {
+					continue
+				}
+				if err32 != nil {
+					t.Errorf("%s() False negative on float32 | Error: %s | Values: %v, %v", name, err32, &p1, &p2)
+					continue
+				}
+				converted := Opinion{float64(got.belief), float64(got.disbelief), float64(got.uncertainty), float64(got.baseRate)}
+				if !(Evaluator{Tolerance: 1e-5}).Compare(converted, want) {
+					t.Errorf("%s() Incorrect output on float32 | Output: %v | Expected: %v", name, &got, &want)
+				}
+			}
+		}
+	}
+}
+
 var sink Opinion
 
 // Should do no allocation
@@ -386,3 +466,43 @@ func bmBinarySlFunc(f func(*Opinion, *Opinion) (Opinion, error), b *testing.B) {
 	}
 
 }
+
+// batchSize is the number of opinion pairs per call in the batch benchmarks
+const batchSize = 1024
+
+func bmBatchSlFunc(f func(dst, x, y Opinions) error, b *testing.B) {
+	x, y, dst := MakeOpinions(batchSize), MakeOpinions(batchSize), MakeOpinions(batchSize)
+	for i := range batchSize {
+		x.Set(i, Opinion{0.5, 0.5, 0, 0.2})
+		y.Set(i, Opinion{0.1, 0.9, 0, 0.1})
+	}
+	b.ResetTimer()
+	for range b.N {
+		if err := f(dst, x, y); err != nil {
+			b.Error(err)
+		}
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*batchSize), "ns/opinion")
+}
+
+var sink32 OpinionOf[float32]
+
+// Should do no allocation
+func BenchmarkCumulativeFusionOf32(b *testing.B) {
+	opinion1, err := NewOpinionOf[float32](0.5, 0.5, 0, 0.2)
+	if err != nil {
+		b.Error(err)
+	}
+	opinion2, err := NewOpinionOf[float32](0.1, 0.9, 0, 0.1)
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		x, err := CumulativeFusionOf(&opinion1, &opinion2)
+		if err != nil {
+			b.Error(err)
+		}
+		sink32 = x
+	}
+}
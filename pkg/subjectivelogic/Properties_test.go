@@ -0,0 +1,221 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+var binaryOperators = []struct {
+	name        string
+	op          func(*Opinion, *Opinion) (Opinion, error)
+	commutative bool
+}{
+	{"Addition", Addition, true},
+	{"Multiplication", Multiplication, true},
+	{"Comultiplication", Comultiplication, true},
+	{"ConstraintFusion", ConstraintFusion, true},
+	{"CumulativeFusion", CumulativeFusion, true},
+	{"AveragingFusion", AveragingFusion, true},
+	{"WeightedFusion", WeightedFusion, true},
+	{"TrustDiscounting", TrustDiscounting, false},
+	{"TrustDiscountingOppositeBelief", TrustDiscountingOppositeBelief, false},
+	{"TrustDiscountingUncertaintyFavouring", TrustDiscountingUncertaintyFavouring, false},
+}
+
+// tolerance of the properties that only hold up to rounding errors
+var propertyEvaluator = Evaluator{Tolerance: 1e-9}
+
+/*
+fuzzOpinion maps arbitrary values to a valid Opinion by normalising b, d and u to a sum of 1 and folding a into [0, 1].
+*/
+func fuzzOpinion(b, d, u, a float64) (Opinion, bool) {
+	for _, v := range []float64{b, d, u, a} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return Opinion{}, false
+		}
+	}
+	b, d, u = math.Abs(b), math.Abs(d), math.Abs(u)
+	sum := b + d + u
+	if sum == 0 || math.IsInf(sum, 0) {
+		return Opinion{}, false
+	}
+	o, err := NewOpinion(b/sum, d/sum, 1-b/sum-d/sum, math.Mod(math.Abs(a), 1.0000001))
+	if err != nil {
+		o, err = NewOpinion(b/sum, d/sum, u/sum, math.Min(math.Mod(math.Abs(a), 1.0000001), 1))
+	}
+	return o, err == nil
+}
+
+/*
+randomOpinion returns a random Opinion, which is vacuous, dogmatic or has a base rate of 0 or 1 with a considerable probability.
+*/
+func randomOpinion(r *rand.Rand) Opinion {
+	b, d, u := r.Float64(), r.Float64(), r.Float64()
+	switch r.IntN(6) {
+	case 0:
+		b, d, u = 0, 0, 1
+	case 1:
+		u = 0
+	case 2:
+		b = 0
+	}
+	a := r.Float64()
+	switch r.IntN(4) {
+	case 0:
+		a = 0
+	case 1:
+		a = 1
+	}
+	o, _ := fuzzOpinion(b, d, u, a)
+	return o
+}
+
+/*
+checkBinaryProperties asserts that every binary operator yields a valid Opinion or an *OperatorError for x and y,
+and that commutative operators yield the same result for y and x.
+*/
+func checkBinaryProperties(t *testing.T, x, y Opinion) {
+	t.Helper()
+	for _, op := range binaryOperators {
+		got, err := op.op(&x, &y)
+		if err != nil {
+			var opErr *OperatorError
+			if !errors.As(err, &opErr) || opErr.Op != op.name {
+				t.Errorf("%s(%v, %v) error = %v, want an *OperatorError of %s", op.name, &x, &y, err, op.name)
+			}
+			continue
+		}
+		if !checkInput(got.belief, got.disbelief, got.uncertainty, got.baseRate) {
+			t.Errorf("%s(%v, %v) got the invalid opinion %v", op.name, &x, &y, &got)
+		}
+		if op.commutative {
+			swapped, err := op.op(&y, &x)
+			if err != nil || !propertyEvaluator.Compare(got, swapped) {
+				t.Errorf("%s is not commutative for %v and %v, got %v and %v, %v", op.name, &x, &y, &got, &swapped, err)
+			}
+		}
+	}
+}
+
+/*
+checkComplementProperties asserts that Complement yields a valid Opinion and is an involution.
+*/
+func checkComplementProperties(t *testing.T, x Opinion) {
+	t.Helper()
+	complement, err := Complement(&x)
+	if err != nil {
+		t.Fatalf("Complement(%v) error = %v", &x, err)
+	}
+	twice, err := Complement(&complement)
+	if err != nil || !propertyEvaluator.Compare(twice, x) {
+		t.Errorf("Complement(Complement(%v)) got = %v, %v", &x, &twice, err)
+	}
+}
+
+/*
+checkFusionProperties asserts that fusing with a vacuous opinion is neutral for cumulative and weighted fusion
+and that cumulative fusion is associative for opinions that are neither dogmatic nor vacuous.
+*/
+func checkFusionProperties(t *testing.T, x, y, z Opinion) {
+	t.Helper()
+	vacuous := Opinion{0, 0, 1, z.baseRate}
+	if x.uncertainty < 1 {
+		for name, fusion := range map[string]func(*Opinion, *Opinion) (Opinion, error){"CumulativeFusion": CumulativeFusion, "WeightedFusion": WeightedFusion} {
+			got, err := fusion(&x, &vacuous)
+			if err != nil || !propertyEvaluator.Compare(got, x) {
+				t.Errorf("%s(%v, %v) got = %v, %v, want %v", name, &x, &vacuous, &got, err, &x)
+			}
+		}
+	}
+
+	for _, o := range []Opinion{x, y, z} {
+		if o.uncertainty < 1e-3 || o.uncertainty > 1-1e-3 {
+			return
+		}
+	}
+	xy, err1 := CumulativeFusion(&x, &y)
+	left, err2 := CumulativeFusion(&xy, &z)
+	yz, err3 := CumulativeFusion(&y, &z)
+	right, err4 := CumulativeFusion(&x, &yz)
+	if err := errors.Join(err1, err2, err3, err4); err != nil || !propertyEvaluator.Compare(left, right) {
+		t.Errorf("CumulativeFusion is not associative for %v, %v and %v, got %v and %v, %v", &x, &y, &z, &left, &right, err)
+	}
+}
+
+func TestProperties(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 10000; i++ {
+		x, y, z := randomOpinion(r), randomOpinion(r), randomOpinion(r)
+		checkBinaryProperties(t, x, y)
+		checkComplementProperties(t, x)
+		checkFusionProperties(t, x, y, z)
+		if t.Failed() {
+			return
+		}
+	}
+}
+
+func addFuzzCorpus(f *testing.F, values int) {
+	edges := [][4]float64{{1, 0, 0, 0.5}, {0, 1, 0, 0}, {0, 0, 1, 1}, {0.6, 0.3, 0.1, 0.5}, {0.2, 0.2, 0.6, 1}, {0.5, 0.5, 0, 0}}
+	for i := range edges {
+		var args []any
+		for j := 0; j < values/4; j++ {
+			for _, v := range edges[(i+j)%len(edges)] {
+				args = append(args, v)
+			}
+		}
+		f.Add(args...)
+	}
+}
+
+func FuzzBinaryOperators(f *testing.F) {
+	addFuzzCorpus(f, 8)
+	f.Fuzz(func(t *testing.T, b1, d1, u1, a1, b2, d2, u2, a2 float64) {
+		x, ok1 := fuzzOpinion(b1, d1, u1, a1)
+		y, ok2 := fuzzOpinion(b2, d2, u2, a2)
+		if !ok1 || !ok2 {
+			t.Skip()
+		}
+		checkBinaryProperties(t, x, y)
+	})
+}
+
+func FuzzComplement(f *testing.F) {
+	addFuzzCorpus(f, 4)
+	f.Fuzz(func(t *testing.T, b, d, u, a float64) {
+		x, ok := fuzzOpinion(b, d, u, a)
+		if !ok {
+			t.Skip()
+		}
+		checkComplementProperties(t, x)
+	})
+}
+
+func FuzzFusion(f *testing.F) {
+	addFuzzCorpus(f, 12)
+	f.Fuzz(func(t *testing.T, b1, d1, u1, a1, b2, d2, u2, a2, b3, d3, u3, a3 float64) {
+		x, ok1 := fuzzOpinion(b1, d1, u1, a1)
+		y, ok2 := fuzzOpinion(b2, d2, u2, a2)
+		z, ok3 := fuzzOpinion(b3, d3, u3, a3)
+		if !ok1 || !ok2 || !ok3 {
+			t.Skip()
+		}
+		checkFusionProperties(t, x, y, z)
+	})
+}
@@ -0,0 +1,159 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"reflect"
+)
+
+/*
+NewOpinionFromQueryable takes a QueryableOpinion q, e.g. an opinion type of another SL implementation, and outputs an Opinion with the values of q,
+as well as an Error. If q is nil, also if it is a nil pointer of a type implementing QueryableOpinion, or its values do not form a valid Opinion,
+a zeroed Opinion and an error are returned.
+The *Opinion of the result is a QueryableOpinion again.
+*/
+func NewOpinionFromQueryable(q QueryableOpinion) (Opinion, error) {
+	return fromQueryable("NewOpinionFromQueryable", q)
+}
+
+/*
+ApplyUnary applies the UnaryOperator op to the QueryableOpinion q, e.g. ApplyUnary(UnaryFunc(Complement), q).
+If op is nil, q is nil or invalid like in NewOpinionFromQueryable, or op fails, a zeroed Opinion and an error are returned.
+*/
+func ApplyUnary(op UnaryOperator, q QueryableOpinion) (Opinion, error) {
+	if isNil(op) {
+		return Opinion{}, newOperatorError[float64]("ApplyUnary", ErrNilInput, "Operator cannot be nil")
+	}
+	opinion, err := fromQueryable("ApplyUnary", q)
+	if err != nil {
+		return Opinion{}, err
+	}
+	return op.Apply(&opinion)
+}
+
+/*
+ApplyBinary applies the BinaryOperator op to the QueryableOpinions q1 and q2, e.g. ApplyBinary(BinaryFunc(CumulativeFusion), q1, q2), like ApplyUnary.
+*/
+func ApplyBinary(op BinaryOperator, q1, q2 QueryableOpinion) (Opinion, error) {
+	if isNil(op) {
+		return Opinion{}, newOperatorError[float64]("ApplyBinary", ErrNilInput, "Operator cannot be nil")
+	}
+	opinion1, err := fromQueryable("ApplyBinary", q1)
+	if err != nil {
+		return Opinion{}, err
+	}
+	opinion2, err := fromQueryable("ApplyBinary", q2)
+	if err != nil {
+		return Opinion{}, err
+	}
+	return op.Apply(&opinion1, &opinion2)
+}
+
+/*
+ApplyNary applies the NaryOperator op to the QueryableOpinions qs, e.g. ApplyNary(NaryFunc(MultiEdgeTrustDisc), path), like ApplyUnary.
+qs may be a slice of any type implementing QueryableOpinion.
+*/
+func ApplyNary[Q QueryableOpinion](op NaryOperator, qs []Q) (Opinion, error) {
+	if isNil(op) {
+		return Opinion{}, newOperatorError[float64]("ApplyNary", ErrNilInput, "Operator cannot be nil")
+	}
+	if qs == nil {
+		return Opinion{}, newOperatorError[float64]("ApplyNary", ErrNilInput, "")
+	}
+	opinions := make([]Opinion, len(qs))
+	for i, q := range qs {
+		var err error
+		if opinions[i], err = fromQueryable("ApplyNary", q); err != nil {
+			return Opinion{}, err
+		}
+	}
+	return op.Apply(opinions)
+}
+
+/*
+Converter applies operators to opinions of type Q, e.g. an opinion type of a domain model, and converts the results back to Q with New.
+New receives the values of a valid Opinion and may fail, e.g. if Q carries additional constraints.
+*/
+type Converter[Q QueryableOpinion] struct {
+	New func(belief, disbelief, uncertainty, baseRate float64) (Q, error)
+}
+
+/*
+Unary is called onto a Converter c and applies the UnaryOperator op to q like ApplyUnary, converting the result to Q.
+If op or the conversion fails, the zero value of Q and an error are returned.
+*/
+func (c Converter[Q]) Unary(op UnaryOperator, q Q) (Q, error) {
+	return c.convert(ApplyUnary(op, q))
+}
+
+/*
+Binary is called onto a Converter c and applies the BinaryOperator op to q1 and q2 like ApplyBinary, converting the result to Q.
+*/
+func (c Converter[Q]) Binary(op BinaryOperator, q1, q2 Q) (Q, error) {
+	return c.convert(ApplyBinary(op, q1, q2))
+}
+
+/*
+Nary is called onto a Converter c and applies the NaryOperator op to qs like ApplyNary, converting the result to Q.
+*/
+func (c Converter[Q]) Nary(op NaryOperator, qs []Q) (Q, error) {
+	return c.convert(ApplyNary(op, qs))
+}
+
+/*
+Convert is called onto a Converter c and converts the Opinion o to Q.
+*/
+func (c Converter[Q]) Convert(o Opinion) (Q, error) {
+	return c.convert(o, nil)
+}
+
+func (c Converter[Q]) convert(o Opinion, err error) (Q, error) {
+	var zero Q
+	if err != nil {
+		return zero, err
+	}
+	if c.New == nil {
+		return zero, newOperatorError("Convert", ErrNilInput, "Converter requires a New function", &o)
+	}
+	return c.New(o.belief, o.disbelief, o.uncertainty, o.baseRate)
+}
+
+/*
+fromQueryable returns the Opinion with the values of q, reporting errors for the function fn.
+*/
+func fromQueryable(fn string, q QueryableOpinion) (Opinion, error) {
+	if isNil(q) {
+		return Opinion{}, newOperatorError[float64](fn, ErrNilInput, "")
+	}
+	o := Opinion{q.Belief(), q.Disbelief(), q.Uncertainty(), q.BaseRate()}
+	if !checkInput(o.belief, o.disbelief, o.uncertainty, o.baseRate) {
+		return Opinion{}, newOperatorError(fn, ErrInvalidOpinion, "", &o)
+	}
+	return o, nil
+}
+
+/*
+isNil returns whether v is nil or holds a nil pointer, function, map, slice, channel or interface, whose methods cannot be called safely.
+*/
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Func, reflect.Map, reflect.Slice, reflect.Chan, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
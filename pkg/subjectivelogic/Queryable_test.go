@@ -0,0 +1,169 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+/*
+reliability is an opinion type of a domain model, which stores its values in public fields and carries additional data.
+*/
+type reliability struct {
+	Sensor     string
+	B, D, U, A float64
+}
+
+func (r reliability) Belief() float64      { return r.B }
+func (r reliability) Disbelief() float64   { return r.D }
+func (r reliability) Uncertainty() float64 { return r.U }
+func (r reliability) BaseRate() float64    { return r.A }
+func (r reliability) String() string {
+	return fmt.Sprintf("%s: %v, %v, %v, %v", r.Sensor, r.B, r.D, r.U, r.A)
+}
+
+func TestNewOpinionFromQueryable(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   QueryableOpinion
+		want    Opinion
+		wantErr error
+	}{
+		{"TestNewOpinionFromQueryable1", reliability{"lidar", 0.6, 0.3, 0.1, 0.5}, Opinion{0.6, 0.3, 0.1, 0.5}, nil},
+		{"TestNewOpinionFromQueryable2", &Opinion{0.2, 0.2, 0.6, 0.1}, Opinion{0.2, 0.2, 0.6, 0.1}, nil},
+		{"TestNewOpinionFromQueryable3", reliability{"lidar", 0.6, 0.3, 0.3, 0.5}, Opinion{}, ErrInvalidOpinion},
+		{"TestNewOpinionFromQueryable4", reliability{}, Opinion{}, ErrInvalidOpinion},
+		{"TestNewOpinionFromQueryable5", nil, Opinion{}, ErrNilInput},
+		{"TestNewOpinionFromQueryable6", (*Opinion)(nil), Opinion{}, ErrNilInput},
+		{"TestNewOpinionFromQueryable7", (*reliability)(nil), Opinion{}, ErrNilInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOpinionFromQueryable(tt.input)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("NewOpinionFromQueryable() got = %v, %v, want %v, %v", &got, err, &tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyQueryable(t *testing.T) {
+	x := reliability{"lidar", 0.6, 0.3, 0.1, 0.5}
+	y := reliability{"radar", 0.2, 0.5, 0.3, 0.4}
+	z := reliability{"camera", 0.7, 0.1, 0.2, 0.3}
+	ox, oy, oz := Opinion{0.6, 0.3, 0.1, 0.5}, Opinion{0.2, 0.5, 0.3, 0.4}, Opinion{0.7, 0.1, 0.2, 0.3}
+
+	got, err := ApplyUnary(UnaryFunc(Complement), x)
+	want, _ := Complement(&ox)
+	if err != nil || got != want {
+		t.Errorf("ApplyUnary() got = %v, %v, want %v", &got, err, &want)
+	}
+	got, err = ApplyBinary(BinaryFunc(CumulativeFusion), x, y)
+	want, _ = CumulativeFusion(&ox, &oy)
+	if err != nil || got != want {
+		t.Errorf("ApplyBinary() got = %v, %v, want %v", &got, err, &want)
+	}
+	got, err = ApplyNary(NaryFunc(MultiEdgeTrustDisc), []reliability{x, y, z})
+	want, _ = MultiEdgeTrustDisc([]Opinion{ox, oy, oz})
+	if err != nil || got != want {
+		t.Errorf("ApplyNary() got = %v, %v, want %v", &got, err, &want)
+	}
+	// operators can be taken from a Registry
+	op, _ := DefaultRegistry.Binary("disc_uf")
+	got, err = ApplyBinary(op, x, &oy)
+	want, _ = TrustDiscountingUncertaintyFavouring(&ox, &oy)
+	if err != nil || got != want {
+		t.Errorf("ApplyBinary() got = %v, %v, want %v", &got, err, &want)
+	}
+
+	if _, err := ApplyBinary(BinaryFunc(CumulativeFusion), x, nil); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyBinary() error = %v, want %v", err, ErrNilInput)
+	}
+	if _, err := ApplyNary[reliability](NaryFunc(MultiEdgeTrustDisc), nil); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyNary() error = %v, want %v", err, ErrNilInput)
+	}
+	if _, err := ApplyBinary(BinaryFunc(CumulativeFusion), x, (*Opinion)(nil)); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyBinary() error = %v, want %v", err, ErrNilInput)
+	}
+	if _, err := ApplyNary(NaryFunc(MultiEdgeTrustDisc), []*Opinion{&ox, nil}); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyNary() error = %v, want %v", err, ErrNilInput)
+	}
+
+	// operators that are nil, e.g. because a Registry has no operator of the name
+	unknown, _ := DefaultRegistry.Binary("unknown")
+	if _, err := ApplyBinary(unknown, x, y); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyBinary() error = %v, want %v", err, ErrNilInput)
+	}
+	if _, err := ApplyUnary(nil, x); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyUnary() error = %v, want %v", err, ErrNilInput)
+	}
+	if _, err := ApplyUnary(UnaryFunc(nil), x); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyUnary() error = %v, want %v", err, ErrNilInput)
+	}
+	if _, err := ApplyNary(NaryFunc(nil), []reliability{x, y}); !errors.Is(err, ErrNilInput) {
+		t.Errorf("ApplyNary() error = %v, want %v", err, ErrNilInput)
+	}
+	invalid := reliability{"broken", 0.5, 0.5, 0.5, 0.5}
+	var opErr *OperatorError
+	if _, err := ApplyUnary(UnaryFunc(Complement), invalid); !errors.As(err, &opErr) || opErr.Op != "ApplyUnary" ||
+		opErr.Inputs[0] != (Opinion{0.5, 0.5, 0.5, 0.5}) {
+		t.Errorf("ApplyUnary() error = %#v, want an *OperatorError of ApplyUnary recording the input", err)
+	}
+	conflict := reliability{"conflict", 0, 1, 0, 0.5}
+	certain := reliability{"certain", 1, 0, 0, 0.5}
+	if _, err := ApplyBinary(BinaryFunc(ConstraintFusion), conflict, certain); !errors.Is(err, ErrTotalConflict) {
+		t.Errorf("ApplyBinary() error = %v, want %v", err, ErrTotalConflict)
+	}
+}
+
+func TestConverter(t *testing.T) {
+	c := Converter[reliability]{New: func(b, d, u, a float64) (reliability, error) {
+		return reliability{"fused", b, d, u, a}, nil
+	}}
+	x := reliability{"lidar", 0.6, 0.3, 0.1, 0.5}
+	y := reliability{"radar", 0.2, 0.5, 0.3, 0.4}
+	ox, oy := Opinion{0.6, 0.3, 0.1, 0.5}, Opinion{0.2, 0.5, 0.3, 0.4}
+
+	got, err := c.Binary(BinaryFunc(AveragingFusion), x, y)
+	want, _ := AveragingFusion(&ox, &oy)
+	if err != nil || got != (reliability{"fused", want.belief, want.disbelief, want.uncertainty, want.baseRate}) {
+		t.Errorf("Binary() got = %v, %v, want %v", got, err, &want)
+	}
+	if got, err := c.Unary(UnaryFunc(Complement), x); err != nil || got != (reliability{"fused", 0.3, 0.6, 0.1, 0.5}) {
+		t.Errorf("Unary() got = %v, %v", got, err)
+	}
+	if got, err := c.Nary(NaryFunc(MultiEdgeTrustDisc), []reliability{x, y}); err != nil || got.Sensor != "fused" {
+		t.Errorf("Nary() got = %v, %v", got, err)
+	}
+	if got, err := c.Convert(ox); err != nil || got != (reliability{"fused", 0.6, 0.3, 0.1, 0.5}) {
+		t.Errorf("Convert() got = %v, %v", got, err)
+	}
+
+	if got, err := c.Binary(BinaryFunc(CumulativeFusion), x, reliability{}); !errors.Is(err, ErrInvalidOpinion) || got != (reliability{}) {
+		t.Errorf("Binary() got = %v, %v, want %v", got, err, ErrInvalidOpinion)
+	}
+	errRejected := errors.New("rejected")
+	rejecting := Converter[reliability]{New: func(b, d, u, a float64) (reliability, error) {
+		return reliability{}, errRejected
+	}}
+	if _, err := rejecting.Unary(UnaryFunc(Complement), x); !errors.Is(err, errRejected) {
+		t.Errorf("Unary() error = %v, want %v", err, errRejected)
+	}
+	if _, err := (Converter[reliability]{}).Convert(ox); !errors.Is(err, ErrNilInput) {
+		t.Errorf("Convert() error = %v, want %v", err, ErrNilInput)
+	}
+}
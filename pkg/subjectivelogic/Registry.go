@@ -0,0 +1,269 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+/*
+UnaryOperator is an operator on a single Opinion, e.g. Complement.
+*/
+type UnaryOperator interface {
+	Apply(opinion *Opinion) (Opinion, error)
+}
+
+/*
+BinaryOperator is an operator on two Opinions, e.g. CumulativeFusion.
+*/
+type BinaryOperator interface {
+	Apply(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error)
+}
+
+/*
+NaryOperator is an operator on a variable number of Opinions, e.g. MultiEdgeTrustDisc.
+*/
+type NaryOperator interface {
+	Apply(opinions []Opinion) (Opinion, error)
+}
+
+/*
+UnaryFunc is a function that is used as a UnaryOperator.
+*/
+type UnaryFunc func(opinion *Opinion) (Opinion, error)
+
+/*
+Apply is called onto a UnaryFunc f and returns f(opinion).
+*/
+func (f UnaryFunc) Apply(opinion *Opinion) (Opinion, error) {
+	return f(opinion)
+}
+
+/*
+BinaryFunc is a function that is used as a BinaryOperator.
+*/
+type BinaryFunc func(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error)
+
+/*
+Apply is called onto a BinaryFunc f and returns f(opinion1, opinion2).
+*/
+func (f BinaryFunc) Apply(opinion1 *Opinion, opinion2 *Opinion) (Opinion, error) {
+	return f(opinion1, opinion2)
+}
+
+/*
+NaryFunc is a function that is used as a NaryOperator.
+*/
+type NaryFunc func(opinions []Opinion) (Opinion, error)
+
+/*
+Apply is called onto a NaryFunc f and returns f(opinions).
+*/
+func (f NaryFunc) Apply(opinions []Opinion) (Opinion, error) {
+	return f(opinions)
+}
+
+/*
+Registry maps names to operators, so operators can be chosen by name, e.g. from a configuration file.
+Every name refers to exactly one operator, which is either a UnaryOperator, a BinaryOperator or a NaryOperator.
+A Registry is safe for concurrent use. The zero value is not usable, registries are created with NewRegistry.
+*/
+type Registry struct {
+	mu        sync.RWMutex
+	operators map[string]registered
+}
+
+/*
+registered holds an operator of a Registry with its arity. An arity of -1 marks a NaryOperator,
+minArity then is the minimal number of Opinions it accepts.
+*/
+type registered struct {
+	unary    UnaryOperator
+	binary   BinaryOperator
+	nary     NaryOperator
+	arity    int
+	minArity int
+}
+
+/*
+DefaultRegistry holds all operators of this package under the names used by the expression language, the command-line tool, the REPL and the web service.
+Operators registered with DefaultRegistry become available to the latter three; formulas of the expression package only use them if it is passed explicitly.
+*/
+var DefaultRegistry = NewStandardRegistry()
+
+/*
+NewRegistry returns an empty *Registry.
+*/
+func NewRegistry() *Registry {
+	return &Registry{operators: map[string]registered{}}
+}
+
+/*
+NewStandardRegistry returns a *Registry holding all operators of this package:
+add, not, mul, comul, fuse_cum, fuse_avg, fuse_wgt, fuse_con, disc, disc_ob, disc_uf and disc_multi.
+*/
+func NewStandardRegistry() *Registry {
+	r := NewRegistry()
+	r.operators["add"] = registered{binary: BinaryFunc(Addition), arity: 2}
+	r.operators["not"] = registered{unary: UnaryFunc(Complement), arity: 1}
+	r.operators["mul"] = registered{binary: BinaryFunc(Multiplication), arity: 2}
+	r.operators["comul"] = registered{binary: BinaryFunc(Comultiplication), arity: 2}
+	r.operators["fuse_cum"] = registered{binary: BinaryFunc(CumulativeFusion), arity: 2}
+	r.operators["fuse_avg"] = registered{binary: BinaryFunc(AveragingFusion), arity: 2}
+	r.operators["fuse_wgt"] = registered{binary: BinaryFunc(WeightedFusion), arity: 2}
+	r.operators["fuse_con"] = registered{binary: BinaryFunc(ConstraintFusion), arity: 2}
+	r.operators["disc"] = registered{binary: BinaryFunc(TrustDiscounting), arity: 2}
+	r.operators["disc_ob"] = registered{binary: BinaryFunc(TrustDiscountingOppositeBelief), arity: 2}
+	r.operators["disc_uf"] = registered{binary: BinaryFunc(TrustDiscountingUncertaintyFavouring), arity: 2}
+	r.operators["disc_multi"] = registered{nary: NaryFunc(MultiEdgeTrustDisc), arity: -1, minArity: 2}
+	return r
+}
+
+/*
+RegisterUnary is called onto a *Registry r and registers the UnaryOperator op under name.
+If op is nil or name is empty or already taken, r is left unchanged and an error is returned.
+*/
+func (r *Registry) RegisterUnary(name string, op UnaryOperator) error {
+	if op == nil {
+		return newOperatorError[float64]("RegisterUnary", ErrNilInput, "")
+	}
+	return r.register("RegisterUnary", name, registered{unary: op, arity: 1})
+}
+
+/*
+RegisterBinary is called onto a *Registry r and registers the BinaryOperator op under name, like RegisterUnary.
+*/
+func (r *Registry) RegisterBinary(name string, op BinaryOperator) error {
+	if op == nil {
+		return newOperatorError[float64]("RegisterBinary", ErrNilInput, "")
+	}
+	return r.register("RegisterBinary", name, registered{binary: op, arity: 2})
+}
+
+/*
+RegisterNary is called onto a *Registry r and registers the NaryOperator op under name, like RegisterUnary.
+Apply rejects calls of op with fewer than minArity Opinions.
+*/
+func (r *Registry) RegisterNary(name string, op NaryOperator, minArity int) error {
+	if op == nil {
+		return newOperatorError[float64]("RegisterNary", ErrNilInput, "")
+	}
+	return r.register("RegisterNary", name, registered{nary: op, arity: -1, minArity: max(minArity, 0)})
+}
+
+func (r *Registry) register(fn, name string, op registered) error {
+	if name == "" {
+		return newOperatorError[float64](fn, ErrUnknownOperator, "Name cannot be empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.operators[name]; ok {
+		return newOperatorError[float64](fn, ErrDuplicateOperator, fmt.Sprintf("Operator %q is already registered", name))
+	}
+	r.operators[name] = op
+	return nil
+}
+
+/*
+Unregister is called onto a *Registry r and removes the operator registered under name. It returns false, if there was none.
+*/
+func (r *Registry) Unregister(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.operators[name]
+	delete(r.operators, name)
+	return ok
+}
+
+/*
+Unary is called onto a *Registry r and returns the UnaryOperator registered under name, if any.
+*/
+func (r *Registry) Unary(name string) (UnaryOperator, bool) {
+	op, _ := r.lookup(name)
+	return op.unary, op.unary != nil
+}
+
+/*
+Binary is called onto a *Registry r and returns the BinaryOperator registered under name, if any.
+*/
+func (r *Registry) Binary(name string) (BinaryOperator, bool) {
+	op, _ := r.lookup(name)
+	return op.binary, op.binary != nil
+}
+
+/*
+Nary is called onto a *Registry r and returns the NaryOperator registered under name, if any.
+*/
+func (r *Registry) Nary(name string) (NaryOperator, bool) {
+	op, _ := r.lookup(name)
+	return op.nary, op.nary != nil
+}
+
+/*
+Arity is called onto a *Registry r and returns the number of Opinions the operator registered under name accepts.
+For a NaryOperator, arity is -1 and minArity is the minimal number of Opinions. If there is no such operator, ok is false.
+*/
+func (r *Registry) Arity(name string) (arity, minArity int, ok bool) {
+	op, ok := r.lookup(name)
+	return op.arity, op.minArity, ok
+}
+
+/*
+Names is called onto a *Registry r and returns the names of all registered operators, sorted alphabetically.
+*/
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.operators))
+	for name := range r.operators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+Apply is called onto a *Registry r and applies the operator registered under name to the Opinions,
+e.g. r.Apply("fuse_cum", o1, o2) returns CumulativeFusion(&o1, &o2).
+An error is returned if there is no such operator, if the number of Opinions does not match its arity, or if the operator fails.
+*/
+func (r *Registry) Apply(name string, opinions ...Opinion) (Opinion, error) {
+	op, ok := r.lookup(name)
+	if !ok {
+		return Opinion{}, newOperatorError[float64]("Apply", ErrUnknownOperator, fmt.Sprintf("Unknown operator %q", name))
+	}
+	switch {
+	case op.arity >= 0 && len(opinions) != op.arity:
+		return Opinion{}, newOperatorError[float64]("Apply", ErrUndefined, fmt.Sprintf("%s expects %d opinions, got %d", name, op.arity, len(opinions)))
+	case op.arity < 0 && len(opinions) < op.minArity:
+		return Opinion{}, newOperatorError[float64]("Apply", ErrUndefined, fmt.Sprintf("%s expects at least %d opinions, got %d", name, op.minArity, len(opinions)))
+	}
+	switch {
+	case op.unary != nil:
+		return op.unary.Apply(&opinions[0])
+	case op.binary != nil:
+		return op.binary.Apply(&opinions[0], &opinions[1])
+	}
+	return op.nary.Apply(opinions)
+}
+
+func (r *Registry) lookup(name string) (registered, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	op, ok := r.operators[name]
+	return op, ok
+}
@@ -0,0 +1,141 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRegistry_Apply(t *testing.T) {
+	x := Opinion{0.6, 0.3, 0.1, 0.5}
+	y := Opinion{0.2, 0.5, 0.3, 0.4}
+	z := Opinion{0.7, 0.1, 0.2, 0.3}
+	r := NewStandardRegistry()
+
+	want := func(o Opinion, _ error) Opinion { return o }
+	tests := []struct {
+		name     string
+		operator string
+		opinions []Opinion
+		want     Opinion
+		wantErr  error
+	}{
+		{"TestRegistry_Apply1", "not", []Opinion{x}, want(Complement(&x)), nil},
+		{"TestRegistry_Apply2", "fuse_cum", []Opinion{x, y}, want(CumulativeFusion(&x, &y)), nil},
+		{"TestRegistry_Apply3", "disc_uf", []Opinion{x, y}, want(TrustDiscountingUncertaintyFavouring(&x, &y)), nil},
+		{"TestRegistry_Apply4", "disc_multi", []Opinion{x, y, z}, want(MultiEdgeTrustDisc([]Opinion{x, y, z})), nil},
+		{"TestRegistry_Apply5", "unknown", []Opinion{x, y}, Opinion{}, ErrUnknownOperator},
+		{"TestRegistry_Apply6", "fuse_cum", []Opinion{x}, Opinion{}, ErrUndefined},
+		{"TestRegistry_Apply7", "not", nil, Opinion{}, ErrUndefined},
+		{"TestRegistry_Apply8", "disc_multi", []Opinion{x}, Opinion{}, ErrUndefined},
+		{"TestRegistry_Apply9", "fuse_cum", []Opinion{{}, y}, Opinion{}, ErrNullOpinion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Apply(tt.operator, tt.opinions...)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("Apply() got = %v, %v, want %v, %v", &got, err, &tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := NewRegistry()
+	if len(r.Names()) != 0 {
+		t.Fatalf("Names() = %v, want none", r.Names())
+	}
+
+	if err := r.RegisterBinary("fuse", BinaryFunc(AveragingFusion)); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.RegisterUnary("not", UnaryFunc(Complement)); err != nil {
+		t.Fatal(err)
+	}
+	path := NaryFunc(func(opinions []Opinion) (Opinion, error) {
+		return DiscountPath(UncertaintyFavouringDiscounter, opinions)
+	})
+	if err := r.RegisterNary("path", path, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.RegisterUnary("fuse", UnaryFunc(Complement)); !errors.Is(err, ErrDuplicateOperator) {
+		t.Errorf("RegisterUnary() error = %v, want %v", err, ErrDuplicateOperator)
+	}
+	if err := r.RegisterBinary("", BinaryFunc(AveragingFusion)); !errors.Is(err, ErrUnknownOperator) {
+		t.Errorf("RegisterBinary() error = %v, want %v", err, ErrUnknownOperator)
+	}
+	if err := r.RegisterBinary("other", nil); !errors.Is(err, ErrNilInput) {
+		t.Errorf("RegisterBinary() error = %v, want %v", err, ErrNilInput)
+	}
+	if names := r.Names(); !slices.Equal(names, []string{"fuse", "not", "path"}) {
+		t.Errorf("Names() = %v, want [fuse not path]", names)
+	}
+
+	if _, ok := r.Binary("fuse"); !ok {
+		t.Errorf("Binary() found no operator fuse")
+	}
+	if _, ok := r.Unary("fuse"); ok {
+		t.Errorf("Unary() found the binary operator fuse")
+	}
+	if op, ok := r.Nary("path"); !ok || op == nil {
+		t.Errorf("Nary() found no operator path")
+	}
+	if arity, minArity, ok := r.Arity("path"); !ok || arity != -1 || minArity != 2 {
+		t.Errorf("Arity() = %d, %d, %t, want -1, 2, true", arity, minArity, ok)
+	}
+	if _, _, ok := r.Arity("unknown"); ok {
+		t.Errorf("Arity() found unknown operator")
+	}
+
+	x := Opinion{0.6, 0.3, 0.1, 0.5}
+	y := Opinion{0.2, 0.5, 0.3, 0.4}
+	got, err := r.Apply("path", x, y)
+	want, _ := TrustDiscountingUncertaintyFavouring(&x, &y)
+	if err != nil || got != want {
+		t.Errorf("Apply() got = %v, %v, want %v", &got, err, &want)
+	}
+
+	if !r.Unregister("fuse") || r.Unregister("fuse") {
+		t.Errorf("Unregister() did not remove fuse exactly once")
+	}
+	if _, err := r.Apply("fuse", x, y); !errors.Is(err, ErrUnknownOperator) {
+		t.Errorf("Apply() error = %v, want %v", err, ErrUnknownOperator)
+	}
+}
+
+func TestNewStandardRegistry(t *testing.T) {
+	r := NewStandardRegistry()
+	if !slices.Equal(r.Names(), DefaultRegistry.Names()) {
+		t.Errorf("Names() = %v, want %v", r.Names(), DefaultRegistry.Names())
+	}
+	for _, name := range r.Names() {
+		arity, _, _ := r.Arity(name)
+		_, unary := r.Unary(name)
+		_, binary := r.Binary(name)
+		_, nary := r.Nary(name)
+		if (arity == 1) != unary || (arity == 2) != binary || (arity == -1) != nary {
+			t.Errorf("%s: arity %d does not match its operator", name, arity)
+		}
+	}
+	// registries are independent of each other
+	if err := r.RegisterUnary("id", UnaryFunc(func(o *Opinion) (Opinion, error) { return *o, nil })); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := DefaultRegistry.Arity("id"); ok {
+		t.Errorf("RegisterUnary() modified DefaultRegistry")
+	}
+}
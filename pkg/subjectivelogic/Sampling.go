@@ -0,0 +1,138 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+/*
+Sample is called onto an *Opinion o and draws a probability from the Beta distribution o is equivalent to, using the source of randomness r.
+If r is nil, the global source of math/rand/v2 is used. Dogmatic opinions, i.e. opinions with u = 0, always yield their belief mass.
+*/
+func (opinion *OpinionOf[T]) Sample(r *rand.Rand) T {
+	if opinion == nil {
+		panic("Sample(): method call from nil pointer")
+	}
+	if opinion.uncertainty == 0 {
+		return opinion.belief
+	}
+	alpha, beta := opinion.BetaParameters()
+	return T(sampleBeta(r, float64(alpha), float64(beta)))
+}
+
+/*
+Samples is called onto an *Opinion o and draws n probabilities from the Beta distribution o is equivalent to, like Sample.
+*/
+func (opinion *OpinionOf[T]) Samples(r *rand.Rand, n int) []T {
+	samples := make([]T, n)
+	for i := range samples {
+		samples[i] = opinion.Sample(r)
+	}
+	return samples
+}
+
+/*
+NewOpinionFromSamples takes at least two probabilities and a base rate, and outputs the Opinion whose Beta distribution has the
+same mean and variance as the samples, as well as an Error.
+The Opinion is formed from the sample mean and the unbiased sample variance like in NewOpinionFromMoments.
+If a sample lies outside of [0, 1], or the samples are too dispersed to be represented with the given base rate,
+a zeroed Opinion and an error are returned.
+*/
+func NewOpinionFromSamples(samples []float64, baseRate float64) (Opinion, error) {
+	return NewOpinionFromSamplesOf(samples, baseRate)
+}
+
+/*
+NewOpinionFromSamplesOf is the generic counterpart of NewOpinionFromSamples.
+*/
+func NewOpinionFromSamplesOf[T Float](samples []T, baseRate T) (OpinionOf[T], error) {
+	if len(samples) < 2 {
+		return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromSamples", ErrInvalidOpinion, "At least two samples are required")
+	}
+	mean := 0.0
+	for _, p := range samples {
+		if !(0 <= p && p <= 1) {
+			return OpinionOf[T]{}, newOperatorError[T]("NewOpinionFromSamples", ErrInvalidOpinion, "Samples must lie in [0, 1]")
+		}
+		mean += float64(p)
+	}
+	mean /= float64(len(samples))
+	variance := 0.0
+	for _, p := range samples {
+		variance += (float64(p) - mean) * (float64(p) - mean)
+	}
+	variance /= float64(len(samples) - 1)
+
+	return fromMoments("NewOpinionFromSamples", mean, variance, baseRate)
+}
+
+/*
+sampleBeta draws a value from the Beta distribution with the parameters alpha and beta as X/(X+Y) of two Gamma distributed values.
+*/
+func sampleBeta(r *rand.Rand, alpha, beta float64) float64 {
+	if alpha == 0 {
+		return 0
+	}
+	if beta == 0 {
+		return 1
+	}
+	x, y := sampleGamma(r, alpha), sampleGamma(r, beta)
+	if x+y == 0 {
+		// both values underflowed, which only happens for tiny parameters
+		return alpha / (alpha + beta)
+	}
+	return x / (x + y)
+}
+
+/*
+sampleGamma draws a value from the Gamma distribution with the given shape and a scale of 1,
+using the method of Marsaglia and Tsang (2000).
+*/
+func sampleGamma(r *rand.Rand, shape float64) float64 {
+	if shape < 1 {
+		// Gamma(shape) is distributed as Gamma(shape+1) * U^(1/shape)
+		return sampleGamma(r, shape+1) * math.Pow(1-uniform(r), 1/shape)
+	}
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := normal(r)
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := uniform(r)
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+func uniform(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+func normal(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.NormFloat64()
+	}
+	return r.NormFloat64()
+}
@@ -0,0 +1,152 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func moments(samples []float64) (mean, variance float64) {
+	for _, p := range samples {
+		mean += p
+	}
+	mean /= float64(len(samples))
+	for _, p := range samples {
+		variance += (p - mean) * (p - mean)
+	}
+	return mean, variance / float64(len(samples)-1)
+}
+
+func TestSample(t *testing.T) {
+	tests := []struct {
+		name    string
+		opinion Opinion
+	}{
+		{"TestSample1", Opinion{0.6, 0.3, 0.1, 0.5}},
+		{"TestSample2", Opinion{0.2, 0.2, 0.6, 0.5}},
+		// Beta parameters below 1
+		{"TestSample3", Opinion{0, 0, 1, 0.1}},
+		{"TestSample4", Opinion{0.05, 0.1, 0.85, 0.9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			samples := tt.opinion.Samples(rand.New(rand.NewPCG(1, 2)), 100000)
+			alpha, beta := tt.opinion.BetaParameters()
+			wantMean := alpha / (alpha + beta)
+			wantVariance := alpha * beta / ((alpha + beta) * (alpha + beta) * (alpha + beta + 1))
+
+			mean, variance := moments(samples)
+			if math.Abs(mean-wantMean) > 0.005 || math.Abs(variance-wantVariance) > 0.005 {
+				t.Errorf("Samples() mean, variance = %v, %v, want %v, %v", mean, variance, wantMean, wantVariance)
+			}
+			if mean := tt.opinion.ProjectedProbability(); math.Abs(mean-wantMean) > 1e-12 {
+				t.Errorf("ProjectedProbability() = %v, want the mean %v", mean, wantMean)
+			}
+			for _, p := range samples {
+				if !(0 <= p && p <= 1) {
+					t.Fatalf("Samples() got %v outside of [0, 1]", p)
+				}
+			}
+		})
+	}
+
+	dogmatic := Opinion{0.7, 0.3, 0, 0.5}
+	if got := dogmatic.Sample(nil); got != 0.7 {
+		t.Errorf("Sample() of a dogmatic opinion got = %v, want 0.7", got)
+	}
+
+	// the same seed yields the same samples
+	o := Opinion{0.2, 0.2, 0.6, 0.5}
+	s1, s2 := o.Samples(rand.New(rand.NewPCG(3, 4)), 10), o.Samples(rand.New(rand.NewPCG(3, 4)), 10)
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			t.Fatalf("Samples() is not deterministic, got %v and %v", s1, s2)
+		}
+	}
+}
+
+func TestNewOpinionFromSamples(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []float64
+		baseRate float64
+		want     Opinion
+		wantErr  bool
+	}{
+		//invalid input
+		{"TestNewOpinionFromSamples1", []float64{0.5}, 0.5, Opinion{}, true},
+		{"TestNewOpinionFromSamples2", []float64{0.5, 1.5}, 0.5, Opinion{}, true},
+		{"TestNewOpinionFromSamples3", []float64{0.5, math.NaN()}, 0.5, Opinion{}, true},
+		{"TestNewOpinionFromSamples4", []float64{0, 1, 0, 1}, 0.5, Opinion{}, true},
+		{"TestNewOpinionFromSamples5", []float64{0.4, 0.6}, 1.5, Opinion{}, true},
+		// the variance of 0.02 is too large for a mean of 0.9 and a base rate of 0
+		{"TestNewOpinionFromSamples6", []float64{0.8, 1}, 0, Opinion{}, true},
+
+		//general tests
+		{"TestNewOpinionFromSamples7", []float64{0.7, 0.7, 0.7}, 0.5, Opinion{0.7, 0.3, 0, 0.5}, false},
+		// mean 0.5 and variance 0.02 yield alpha = beta = 5.75
+		{"TestNewOpinionFromSamples8", []float64{0.4, 0.6}, 0.5, Opinion{4.75 / 11.5, 4.75 / 11.5, 2 / 11.5, 0.5}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOpinionFromSamples(tt.samples, tt.baseRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOpinionFromSamples() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Compare(tt.want) {
+				t.Errorf("NewOpinionFromSamples() got = %v, want %v", &got, &tt.want)
+			}
+		})
+	}
+}
+
+func TestSamplesRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	e := Evaluator{Tolerance: 0.01}
+	for _, o := range []Opinion{{0.6, 0.3, 0.1, 0.5}, {0.2, 0.2, 0.6, 0.5}, {0.1, 0.4, 0.5, 0.3}} {
+		got, err := NewOpinionFromSamples(o.Samples(r, 200000), o.BaseRate())
+		if err != nil || !e.Compare(got, o) {
+			t.Errorf("NewOpinionFromSamples() of the samples of %v got = %v, %v", &o, &got, err)
+		}
+	}
+}
+
+// Multiplication approximates the product of two independent Beta distributed probabilities by a Beta distribution with the same mean
+func TestSamplesMultiplication(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	x, y := Opinion{0.6, 0.3, 0.1, 0.5}, Opinion{0.5, 0.2, 0.3, 0.4}
+	products := make([]float64, 100000)
+	for i := range products {
+		products[i] = x.Sample(r) * y.Sample(r)
+	}
+	want, _ := Multiplication(&x, &y)
+	if mean, _ := moments(products); math.Abs(mean-want.ProjectedProbability()) > 0.005 {
+		t.Errorf("mean of the products = %v, want %v", mean, want.ProjectedProbability())
+	}
+}
+
+var sinkSample float64
+
+func BenchmarkSample(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2))
+	o := Opinion{0.2, 0.2, 0.6, 0.5}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkSample = o.Sample(r)
+	}
+}
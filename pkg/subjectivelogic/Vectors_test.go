@@ -0,0 +1,115 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package subjectivelogic
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+)
+
+/*
+vector is a test vector of testdata/vectors.json, which is shared with other implementations of subjective logic.
+*/
+type vector struct {
+	Operator  string    `json:"operator"`
+	Source    string    `json:"source"`
+	Reference string    `json:"reference"`
+	Inputs    []Opinion `json:"inputs"`
+	Expected  *Opinion  `json:"expected"`
+	Error     string    `json:"error"`
+}
+
+/*
+vectors is the content of testdata/vectors.json. Published vectors cite a worked example of the literature in their Reference,
+regression vectors were computed by this project.
+*/
+type vectors struct {
+	Tolerance  float64  `json:"tolerance"`
+	Published  []vector `json:"published"`
+	Regression []vector `json:"regression"`
+}
+
+var vectorOperators = map[string]func([]Opinion) (Opinion, error){
+	"Addition":                       func(o []Opinion) (Opinion, error) { return Addition(&o[0], &o[1]) },
+	"Complement":                     func(o []Opinion) (Opinion, error) { return Complement(&o[0]) },
+	"Multiplication":                 func(o []Opinion) (Opinion, error) { return Multiplication(&o[0], &o[1]) },
+	"Comultiplication":               func(o []Opinion) (Opinion, error) { return Comultiplication(&o[0], &o[1]) },
+	"ConstraintFusion":               func(o []Opinion) (Opinion, error) { return ConstraintFusion(&o[0], &o[1]) },
+	"CumulativeFusion":               func(o []Opinion) (Opinion, error) { return CumulativeFusion(&o[0], &o[1]) },
+	"AveragingFusion":                func(o []Opinion) (Opinion, error) { return AveragingFusion(&o[0], &o[1]) },
+	"WeightedFusion":                 func(o []Opinion) (Opinion, error) { return WeightedFusion(&o[0], &o[1]) },
+	"TrustDiscounting":               func(o []Opinion) (Opinion, error) { return TrustDiscounting(&o[0], &o[1]) },
+	"TrustDiscountingOppositeBelief": func(o []Opinion) (Opinion, error) { return TrustDiscountingOppositeBelief(&o[0], &o[1]) },
+	"TrustDiscountingUncertaintyFavouring": func(o []Opinion) (Opinion, error) {
+		return TrustDiscountingUncertaintyFavouring(&o[0], &o[1])
+	},
+	"MultiEdgeTrustDisc": MultiEdgeTrustDisc,
+}
+
+var vectorErrors = map[string]error{
+	"ErrNilInput":       ErrNilInput,
+	"ErrNullOpinion":    ErrNullOpinion,
+	"ErrInvalidOpinion": ErrInvalidOpinion,
+	"ErrUndefined":      ErrUndefined,
+	"ErrTotalConflict":  ErrTotalConflict,
+}
+
+func TestVectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v vectors
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("invalid vectors: %v", err)
+	}
+	e := Evaluator{Tolerance: v.Tolerance}
+
+	for i, tt := range v.Published {
+		if tt.Reference == "" {
+			t.Errorf("published vector %d (%s): no reference", i, tt.Operator)
+		}
+	}
+	covered := map[string]bool{}
+	check := func(section string, vectors []vector) {
+		for i, tt := range vectors {
+			op, ok := vectorOperators[tt.Operator]
+			if !ok {
+				t.Errorf("%s vector %d: unknown operator %q", section, i, tt.Operator)
+				continue
+			}
+			covered[tt.Operator] = true
+			got, err := op(tt.Inputs)
+			if tt.Error != "" {
+				if want := vectorErrors[tt.Error]; want == nil || !errors.Is(err, want) {
+					t.Errorf("%s vector %d (%s, %s): error = %v, want %s", section, i, tt.Operator, tt.Source, err, tt.Error)
+				}
+				continue
+			}
+			if err != nil || tt.Expected == nil || !e.Compare(got, *tt.Expected) {
+				t.Errorf("%s vector %d (%s, %s): got = %v, %v, want %v", section, i, tt.Operator, tt.Source, &got, err, tt.Expected)
+			}
+		}
+	}
+	check("published", v.Published)
+	check("regression", v.Regression)
+	for name := range vectorOperators {
+		if !covered[name] {
+			t.Errorf("no vector for %s", name)
+		}
+	}
+}
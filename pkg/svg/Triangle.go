@@ -0,0 +1,187 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package svg renders binomial opinions into the barycentric opinion triangle of Subjective Logic as SVG images.
+*/
+package svg
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+DefaultSize is the width of a rendered triangle in pixels, if no other size is set.
+*/
+const DefaultSize float64 = 400
+
+const margin = 40
+
+/*
+palette holds the colors opinions are drawn with, in the order they were added to a Triangle.
+*/
+var palette = []string{"#1f77b4", "#d62728", "#2ca02c", "#ff7f0e", "#9467bd", "#8c564b", "#e377c2", "#17becf"}
+
+/*
+Point is an opinion to be drawn into the triangle, together with the label it is annotated with.
+*/
+type Point struct {
+	Label   string
+	Opinion sl.Opinion
+}
+
+/*
+Triangle is an opinion triangle with a number of opinions to be drawn into it.
+Each opinion is drawn as a point together with its base-rate director, a dashed line from the uncertainty vertex to the base rate,
+and its projector, a dotted line parallel to the director that ends in the projected probability on the base line.
+*/
+type Triangle struct {
+	Title string
+	// Size is the width of the image in pixels. DefaultSize is used if Size is not positive.
+	Size float64
+	// HideDirectors and HideProjections suppress the base-rate directors and projectors of all opinions.
+	HideDirectors   bool
+	HideProjections bool
+	Points          []Point
+}
+
+/*
+Add is called onto a *Triangle t and adds the opinion o with the given label to the opinions drawn into t.
+*/
+func (triangle *Triangle) Add(label string, o sl.Opinion) {
+	triangle.Points = append(triangle.Points, Point{Label: label, Opinion: o})
+}
+
+/*
+vertex is a point of the image in pixel coordinates.
+*/
+type vertex struct {
+	x, y float64
+}
+
+func (v vertex) scale(f float64) vertex {
+	return vertex{v.x * f, v.y * f}
+}
+
+func (v vertex) add(w vertex) vertex {
+	return vertex{v.x + w.x, v.y + w.y}
+}
+
+/*
+corners returns the disbelief, belief and uncertainty vertices of the triangle and the height of the image.
+*/
+func (triangle *Triangle) corners() (d, b, u vertex, height float64) {
+	size := triangle.Size
+	if size <= 0 {
+		size = DefaultSize
+	}
+	side := size - 2*margin
+	top := float64(margin)
+	if triangle.Title != "" {
+		top += 20
+	}
+	base := top + side*math.Sqrt(3)/2
+	return vertex{margin, base}, vertex{margin + side, base}, vertex{margin + side/2, top}, base + margin
+}
+
+/*
+WriteSVG is called onto a *Triangle t and writes t as an SVG image to w.
+*/
+func (triangle *Triangle) WriteSVG(w io.Writer) error {
+	if triangle == nil {
+		return errors.New("WriteSVG: Input cannot be nil")
+	}
+	d, b, u, height := triangle.corners()
+	width := b.x + margin
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s" font-family="sans-serif" font-size="12">`+"\n",
+		num(width), num(height), num(width), num(height))
+	fmt.Fprintf(out, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+	if triangle.Title != "" {
+		fmt.Fprintf(out, `<text x="%s" y="%d" text-anchor="middle" font-size="14">%s</text>`+"\n", num(width/2), margin, escape(triangle.Title))
+	}
+
+	fmt.Fprintf(out, `<polygon points="%s,%s %s,%s %s,%s" fill="none" stroke="black"/>`+"\n",
+		num(d.x), num(d.y), num(b.x), num(b.y), num(u.x), num(u.y))
+	fmt.Fprintf(out, `<text x="%s" y="%s" text-anchor="end">d</text>`+"\n", num(d.x-6), num(d.y+14))
+	fmt.Fprintf(out, `<text x="%s" y="%s" text-anchor="start">b</text>`+"\n", num(b.x+6), num(b.y+14))
+	fmt.Fprintf(out, `<text x="%s" y="%s" text-anchor="middle">u</text>`+"\n", num(u.x), num(u.y-8))
+
+	for i, p := range triangle.Points {
+		o := p.Opinion
+		color := palette[i%len(palette)]
+		point := b.scale(o.Belief()).add(d.scale(o.Disbelief())).add(u.scale(o.Uncertainty()))
+		baseRate := d.scale(1 - o.BaseRate()).add(b.scale(o.BaseRate()))
+		projection := d.scale(1 - o.ProjectedProbability()).add(b.scale(o.ProjectedProbability()))
+
+		fmt.Fprintf(out, `<g class="opinion" stroke="%s" fill="%s">`+"\n", color, color)
+		fmt.Fprintf(out, "<title>%s</title>\n", escape(describe(p)))
+		if !triangle.HideDirectors {
+			fmt.Fprintf(out, `<line class="director" x1="%s" y1="%s" x2="%s" y2="%s" stroke-dasharray="6,4"/>`+"\n",
+				num(u.x), num(u.y), num(baseRate.x), num(baseRate.y))
+			fmt.Fprintf(out, `<path class="base-rate" d="M %s %s l -5 8 l 10 0 z"/>`+"\n", num(baseRate.x), num(baseRate.y))
+		}
+		if !triangle.HideProjections {
+			fmt.Fprintf(out, `<line class="projector" x1="%s" y1="%s" x2="%s" y2="%s" stroke-dasharray="2,3"/>`+"\n",
+				num(point.x), num(point.y), num(projection.x), num(projection.y))
+			fmt.Fprintf(out, `<circle class="projected-probability" cx="%s" cy="%s" r="3" fill="white"/>`+"\n", num(projection.x), num(projection.y))
+		}
+		fmt.Fprintf(out, `<circle class="point" cx="%s" cy="%s" r="4"/>`+"\n", num(point.x), num(point.y))
+		if p.Label != "" {
+			fmt.Fprintf(out, `<text x="%s" y="%s" stroke="none">%s</text>`+"\n", num(point.x+7), num(point.y-7), escape(p.Label))
+		}
+		fmt.Fprintln(out, "</g>")
+	}
+
+	fmt.Fprintln(out, "</svg>")
+	return out.Flush()
+}
+
+/*
+Render writes an SVG image of the opinion triangle with the given opinions to w, labelling them ω1, ω2, ... in order.
+*/
+func Render(w io.Writer, opinions ...sl.Opinion) error {
+	var triangle Triangle
+	for i, o := range opinions {
+		triangle.Add(fmt.Sprintf("ω%d", i+1), o)
+	}
+	return triangle.WriteSVG(w)
+}
+
+func describe(p Point) string {
+	text := fmt.Sprintf("(%s)  P = %.4g", p.Opinion.String(), p.Opinion.ProjectedProbability())
+	if p.Label != "" {
+		text = p.Label + " = " + text
+	}
+	return text
+}
+
+func num(f float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+}
+
+func escape(s string) string {
+	var sb strings.Builder
+	_ = xml.EscapeText(&sb, []byte(s))
+	return sb.String()
+}
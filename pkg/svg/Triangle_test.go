@@ -0,0 +1,125 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestTriangle_WriteSVG(t *testing.T) {
+	var nilTriangle *Triangle
+	if err := nilTriangle.WriteSVG(io.Discard); err == nil {
+		t.Errorf("Invalid call from \"nil\" passed undetected")
+	}
+
+	//with the default size the triangle spans from (40, 317.13) over (360, 317.13) to (200, 40)
+	triangle := Triangle{}
+	points := []struct {
+		label      string
+		b, d, u, a float64
+	}{
+		{"belief", 1, 0, 0, 0.5},
+		{"disbelief", 0, 1, 0, 0.5},
+		{"vacuous", 0, 0, 1, 0.25},
+		{"x < y", 0.5, 0, 0.5, 0.5},
+	}
+	for _, p := range points {
+		o, err := sl.NewOpinion(p.b, p.d, p.u, p.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		triangle.Add(p.label, o)
+	}
+
+	var buf bytes.Buffer
+	if err := triangle.WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	decoder := xml.NewDecoder(strings.NewReader(out))
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("WriteSVG() produced invalid XML: %v", err)
+		}
+	}
+
+	wants := []string{
+		`<polygon points="40,317.13 360,317.13 200,40"`,
+		`<circle class="point" cx="360" cy="317.13" r="4"/>`,
+		`<circle class="point" cx="40" cy="317.13" r="4"/>`,
+		`<circle class="point" cx="200" cy="40" r="4"/>`,
+		`<line class="director" x1="200" y1="40" x2="120" y2="317.13"`,
+		`<circle class="projected-probability" cx="120" cy="317.13" r="3" fill="white"/>`,
+		`<circle class="point" cx="280" cy="178.56" r="4"/>`,
+		`<circle class="projected-probability" cx="280" cy="317.13" r="3" fill="white"/>`,
+		`>x &lt; y</text>`,
+	}
+	for _, want := range wants {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteSVG() output does not contain %q", want)
+		}
+	}
+}
+
+func TestTriangle_Hide(t *testing.T) {
+	triangle := Triangle{Title: "Test", Size: 200, HideDirectors: true, HideProjections: true}
+	o, err := sl.NewOpinion(0.2, 0.3, 0.5, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	triangle.Add("", o)
+
+	var buf bytes.Buffer
+	if err := triangle.WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "director") || strings.Contains(out, "projector") {
+		t.Errorf("WriteSVG() output contains hidden elements: %s", out)
+	}
+	if !strings.Contains(out, `width="200"`) || !strings.Contains(out, ">Test</text>") {
+		t.Errorf("WriteSVG() output does not respect size and title: %s", out)
+	}
+}
+
+func TestRender(t *testing.T) {
+	belief, err := sl.NewOpinion(1, 0, 0, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	disbelief, err := sl.NewOpinion(0, 1, 0, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Render(&buf, belief, disbelief); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(buf.String(), `class="point"`) != 2 || !strings.Contains(buf.String(), ">ω2</text>") {
+		t.Errorf("Render() output does not contain both opinions: %s", buf.String())
+	}
+}
@@ -0,0 +1,125 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package temporal provides time-stamped opinions, e.g. about the reliability of a sensor, whose evidence ages with a half-life.
+Aged opinions approach the vacuous opinion, and observations made at different times are fused with cumulative fusion after
+aging them to a common instant, so that older observations weigh less.
+*/
+package temporal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+ErrNoObservation is returned if an opinion is queried at an instant before all observations.
+*/
+var ErrNoObservation = errors.New("No observation at or before the instant")
+
+/*
+Observation is an opinion that was formed at the instant Time.
+*/
+type Observation struct {
+	Opinion sl.Opinion `json:"opinion"`
+	Time    time.Time  `json:"time"`
+}
+
+/*
+MarshalJSON is called onto an Observation obs and returns its JSON object. It has a value receiver, so that the opinion is marshalled
+in the JSON format of the subjectivelogic package even if obs is not addressable, e.g. when obs is passed to json.Marshal by value.
+*/
+func (obs Observation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Opinion *sl.Opinion `json:"opinion"`
+		Time    time.Time   `json:"time"`
+	}{&obs.Opinion, obs.Time})
+}
+
+/*
+Decay takes an Opinion o, its age and a half-life, and outputs the Opinion o ages to, as well as an Error.
+The evidence r and s of o is scaled by 2^(-age/halfLife), i.e. halved after every half-life, while its base rate is kept, so that
+the result approaches the vacuous opinion. Dogmatic opinions, which correspond to an infinite amount of evidence, do not decay.
+If o is not a valid Opinion, age is negative or halfLife is not positive, a zeroed Opinion and an error are returned.
+*/
+func Decay(o sl.Opinion, age, halfLife time.Duration) (sl.Opinion, error) {
+	if age < 0 {
+		return sl.Opinion{}, fmt.Errorf("Decay: age %v must not be negative", age)
+	}
+	if halfLife <= 0 {
+		return sl.Opinion{}, fmt.Errorf("Decay: half-life %v must be positive", halfLife)
+	}
+	return decay(o, math.Exp2(-float64(age)/float64(halfLife)))
+}
+
+/*
+decay scales the evidence of o by the factor lambda. With r = W*b/u and s = W*d/u, the Opinion formed from the evidence lambda*r and lambda*s
+has the masses b' = lambda*b/n, d' = lambda*d/n and u' = u/n with n = lambda*(b+d) + u, which also hold for dogmatic opinions.
+*/
+func decay(o sl.Opinion, lambda float64) (sl.Opinion, error) {
+	b, d, u := o.Belief(), o.Disbelief(), o.Uncertainty()
+	if _, err := sl.NewOpinion(b, d, u, o.BaseRate()); err != nil {
+		return sl.Opinion{}, fmt.Errorf("Decay: %w", err)
+	}
+	n := lambda*(b+d) + u
+	if n == 0 {
+		// o is dogmatic and lambda underflowed, the limit of decay is o itself
+		return o, nil
+	}
+	result, err := sl.NewOpinion(lambda*b/n, lambda*d/n, u/n, o.BaseRate())
+	if err != nil {
+		return sl.Opinion{}, fmt.Errorf("Decay: %w", err)
+	}
+	return result, nil
+}
+
+/*
+At is called onto an Observation obs and returns its Opinion aged to the instant t with Decay.
+If t lies before obs.Time, a zeroed Opinion and an error are returned.
+*/
+func (obs Observation) At(t time.Time, halfLife time.Duration) (sl.Opinion, error) {
+	return Decay(obs.Opinion, t.Sub(obs.Time), halfLife)
+}
+
+/*
+Fuse ages all observations to the instant t and fuses them with subjectivelogic.CumulativeFusion in the given order,
+so that the evidence of every observation is weighted by 2^(-age/halfLife).
+If there are no observations, an observation lies after t, or the fusion fails, a zeroed Opinion and an error are returned.
+*/
+func Fuse(observations []Observation, t time.Time, halfLife time.Duration) (sl.Opinion, error) {
+	if len(observations) == 0 {
+		return sl.Opinion{}, fmt.Errorf("Fuse: %w", ErrNoObservation)
+	}
+	var result sl.Opinion
+	for i, obs := range observations {
+		o, err := obs.At(t, halfLife)
+		if err != nil {
+			return sl.Opinion{}, fmt.Errorf("Fuse: observation %d: %w", i, err)
+		}
+		if i == 0 {
+			result = o
+			continue
+		}
+		if result, err = sl.CumulativeFusion(&result, &o); err != nil {
+			return sl.Opinion{}, fmt.Errorf("Fuse: observation %d: %w", i, err)
+		}
+	}
+	return result, nil
+}
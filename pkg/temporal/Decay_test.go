@@ -0,0 +1,188 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package temporal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+var compare = sl.Evaluator{Tolerance: 1e-9}.Compare
+
+func TestDecay(t *testing.T) {
+	hour := time.Hour
+	// decaying an opinion scales its evidence
+	tests := []struct {
+		name     string
+		r, s, a  float64
+		age      time.Duration
+		halfLife time.Duration
+		wantR    float64
+		wantS    float64
+		wantErr  bool
+	}{
+		{"TestDecay1", 8, 2, 0.3, hour, hour, 4, 1, false},
+		{"TestDecay2", 8, 2, 0.3, 3 * hour, hour, 1, 0.25, false},
+		{"TestDecay3", 8, 2, 0.3, 0, hour, 8, 2, false},
+		{"TestDecay4", 8, 2, 0.3, 30 * time.Minute, hour, 8 / 1.4142135623730951, 2 / 1.4142135623730951, false},
+		{"TestDecay5", 8, 2, 0.3, 1 << 62, time.Nanosecond, 0, 0, false},
+		{"TestDecay6", 0, 0, 0.7, hour, hour, 0, 0, false},
+		{"TestDecay7", 8, 2, 0.3, -hour, hour, 0, 0, true},
+		{"TestDecay8", 8, 2, 0.3, hour, 0, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opinion, err := sl.NewOpinionFromEvidence(tt.r, tt.s, tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Decay(opinion, tt.age, tt.halfLife)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if got != (sl.Opinion{}) {
+					t.Errorf("Decay() got = %v on error, want a zeroed opinion", &got)
+				}
+				return
+			}
+			want, err := sl.NewOpinionFromEvidence(tt.wantR, tt.wantS, tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !compare(got, want) {
+				t.Errorf("Decay() got = %v, want %v", &got, &want)
+			}
+		})
+	}
+
+	// dogmatic opinions hold infinite evidence, which does not decay, and the null opinion is invalid
+	dogmatic, err := sl.NewOpinion(0.6, 0.4, 0, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct{ age, halfLife time.Duration }{{10 * hour, hour}, {1 << 62, time.Nanosecond}} {
+		if got, err := Decay(dogmatic, tt.age, tt.halfLife); err != nil || !compare(got, dogmatic) {
+			t.Errorf("Decay() of a dogmatic opinion got = %v, %v, want %v", &got, err, &dogmatic)
+		}
+		if got, err := Decay(sl.Opinion{}, tt.age, tt.halfLife); err == nil || got != (sl.Opinion{}) {
+			t.Errorf("Decay() of the null opinion got = %v, %v, want an error", &got, err)
+		}
+	}
+}
+
+func TestFuse(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	halfLife := time.Hour
+	var observations []Observation
+	for _, tt := range []struct {
+		r, s float64
+		age  time.Duration
+	}{{8, 2, 2 * time.Hour}, {1, 3, time.Hour}, {2, 0, 0}} {
+		o, err := sl.NewOpinionFromEvidence(tt.r, tt.s, 0.5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		observations = append(observations, Observation{o, now.Add(-tt.age)})
+	}
+
+	// the evidence of the observations is weighted by 1/4, 1/2 and 1
+	want, err := sl.NewOpinionFromEvidence(8.0/4+1.0/2+2, 2.0/4+3.0/2, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Fuse(observations, now, halfLife); err != nil || !compare(got, want) {
+		t.Errorf("Fuse() got = %v, %v, want %v", &got, err, &want)
+	}
+	if want, err = sl.NewOpinionFromEvidence(2, 0.5, 0.5); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Fuse(observations[:1], now, halfLife); err != nil || !compare(got, want) {
+		t.Errorf("Fuse() got = %v, %v, want %v", &got, err, &want)
+	}
+
+	if _, err := Fuse(nil, now, halfLife); !errors.Is(err, ErrNoObservation) {
+		t.Errorf("Fuse() error = %v, want %v", err, ErrNoObservation)
+	}
+	if _, err := Fuse(observations, now.Add(-time.Minute), halfLife); err == nil {
+		t.Errorf("Fuse() with observation after the instant passed undetected")
+	}
+	belief, err := sl.NewOpinion(1, 0, 0, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	disbelief, err := sl.NewOpinion(0, 1, 0, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conflicting := []Observation{{belief, now}, {disbelief, now}}
+	if _, err := Fuse(conflicting, now, halfLife); err != nil {
+		t.Errorf("Fuse() error = %v, want dogmatic opinions to be fused", err)
+	}
+	nullOpinion := []Observation{observations[2], {sl.Opinion{}, now}}
+	if _, err := Fuse(nullOpinion, now, halfLife); err == nil {
+		t.Errorf("Fuse() with null opinion passed undetected")
+	}
+}
+
+func TestObservation_JSON(t *testing.T) {
+	o, err := sl.NewOpinionFromEvidence(6, 2, 0.4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obs := Observation{o, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+
+	// the elements of a map are not addressable
+	data, err := json.Marshal(map[string]Observation{"lidar": obs})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var got map[string]Observation
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v of %s", err, data)
+	}
+	if !compare(got["lidar"].Opinion, obs.Opinion) || !got["lidar"].Time.Equal(obs.Time) {
+		t.Errorf("JSON round trip got = %+v from %s, want %+v", got["lidar"], data, obs)
+	}
+
+	byValue, _ := json.Marshal(obs)
+	byPointer, _ := json.Marshal(&obs)
+	if string(byValue) != string(byPointer) {
+		t.Errorf("json.Marshal() got = %s by value, %s by pointer", byValue, byPointer)
+	}
+}
+
+func TestObservation_At(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	o, err := sl.NewOpinionFromEvidence(6, 2, 0.4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := sl.NewOpinionFromEvidence(1.5, 0.5, 0.4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obs := Observation{o, now}
+	if got, err := obs.At(now.Add(2*time.Hour), time.Hour); err != nil || !compare(got, want) {
+		t.Errorf("At() got = %v, %v, want %v", &got, err, &want)
+	}
+	if _, err := obs.At(now.Add(-time.Second), time.Hour); err == nil {
+		t.Errorf("At() before the observation passed undetected")
+	}
+}
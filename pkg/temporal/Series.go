@@ -0,0 +1,97 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package temporal
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Series is a time series of observations about a single proposition, e.g. the reliability of a sensor, whose evidence ages with HalfLife.
+The zero value is not usable, series are created with NewSeries. A Series is not safe for concurrent use.
+*/
+type Series struct {
+	// HalfLife is the time after which the evidence of an observation counts half.
+	HalfLife     time.Duration
+	observations []Observation
+}
+
+/*
+NewSeries returns an empty *Series whose evidence ages with halfLife. If halfLife is not positive, nil and an error are returned.
+*/
+func NewSeries(halfLife time.Duration) (*Series, error) {
+	if halfLife <= 0 {
+		return nil, fmt.Errorf("NewSeries: half-life %v must be positive", halfLife)
+	}
+	return &Series{HalfLife: halfLife}, nil
+}
+
+/*
+Add is called onto a *Series s and adds the Opinion o observed at the instant t. Observations may be added in any order,
+observations with the same instant are kept in the order they were added.
+*/
+func (s *Series) Add(o sl.Opinion, t time.Time) {
+	i := sort.Search(len(s.observations), func(i int) bool {
+		return s.observations[i].Time.After(t)
+	})
+	s.observations = append(s.observations, Observation{})
+	copy(s.observations[i+1:], s.observations[i:])
+	s.observations[i] = Observation{Opinion: o, Time: t}
+}
+
+/*
+Len is called onto a *Series s and returns the number of observations of s.
+*/
+func (s *Series) Len() int {
+	return len(s.observations)
+}
+
+/*
+Observations is called onto a *Series s and returns a copy of all observations of s, sorted by time.
+*/
+func (s *Series) Observations() []Observation {
+	return append([]Observation(nil), s.observations...)
+}
+
+/*
+At is called onto a *Series s and returns the opinion at the instant t, i.e. the observations made at or before t fused with Fuse.
+Observations after t are ignored. If there is no observation at or before t, ErrNoObservation is returned.
+*/
+func (s *Series) At(t time.Time) (sl.Opinion, error) {
+	n := sort.Search(len(s.observations), func(i int) bool {
+		return s.observations[i].Time.After(t)
+	})
+	if n == 0 {
+		return sl.Opinion{}, fmt.Errorf("At: %v: %w", t, ErrNoObservation)
+	}
+	return Fuse(s.observations[:n], t, s.HalfLife)
+}
+
+/*
+Prune is called onto a *Series s and removes the observations older than maxAge at the instant t, whose evidence has decayed
+to at most 2^(-maxAge/HalfLife) of its original amount. It returns the number of removed observations.
+*/
+func (s *Series) Prune(t time.Time, maxAge time.Duration) int {
+	cutoff := t.Add(-maxAge)
+	n := sort.Search(len(s.observations), func(i int) bool {
+		return !s.observations[i].Time.Before(cutoff)
+	})
+	s.observations = append(s.observations[:0], s.observations[n:]...)
+	return n
+}
@@ -0,0 +1,104 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package temporal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestSeries(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	if _, err := NewSeries(0); err == nil {
+		t.Errorf("NewSeries() with zero half-life passed undetected")
+	}
+	s, err := NewSeries(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// observations are added out of order
+	for _, tt := range []struct {
+		r, s float64
+		age  time.Duration
+	}{{1, 3, time.Hour}, {2, 0, 0}, {8, 2, 2 * time.Hour}} {
+		o, err := sl.NewOpinionFromEvidence(tt.r, tt.s, 0.5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Add(o, now.Add(-tt.age))
+	}
+	if s.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", s.Len())
+	}
+	observations := s.Observations()
+	for i := 1; i < len(observations); i++ {
+		if observations[i].Time.Before(observations[i-1].Time) {
+			t.Fatalf("Observations() = %v, want them sorted by time", observations)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		instant time.Time
+		wantR   float64
+		wantS   float64
+		wantErr error
+	}{
+		{"TestSeries1", now, 8.0/4 + 1.0/2 + 2, 2.0/4 + 3.0/2, nil},
+		// the observation at now is ignored before now
+		{"TestSeries2", now.Add(-time.Hour), 8.0/2 + 1, 2.0/2 + 3, nil},
+		{"TestSeries3", now.Add(time.Hour), 8.0/8 + 1.0/4 + 1, 2.0/8 + 3.0/4, nil},
+		{"TestSeries4", now.Add(-3 * time.Hour), 0, 0, ErrNoObservation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.At(tt.instant)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("At() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			want, err := sl.NewOpinionFromEvidence(tt.wantR, tt.wantS, 0.5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !compare(got, want) {
+				t.Errorf("At() got = %v, want %v", &got, &want)
+			}
+		})
+	}
+
+	// the same instant keeps the order of addition
+	last, err := sl.NewOpinionFromEvidence(0, 1, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Add(last, now)
+	if observations := s.Observations(); observations[3].Opinion != last {
+		t.Errorf("Add() did not append after the observations of the same instant: %v", observations)
+	}
+
+	if n := s.Prune(now, 90*time.Minute); n != 1 || s.Len() != 3 {
+		t.Errorf("Prune() = %d, Len() = %d, want 1, 3", n, s.Len())
+	}
+	if n := s.Prune(now, 0); n != 1 || s.Len() != 2 {
+		t.Errorf("Prune() = %d, Len() = %d, want 1, 2", n, s.Len())
+	}
+}
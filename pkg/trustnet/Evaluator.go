@@ -0,0 +1,321 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package trustnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+ErrNoPath is returned if there is no path of trust from the source to the target of a derivation.
+*/
+var ErrNoPath = errors.New("No path from source to target")
+
+/*
+DefaultMaxLength is the maximum number of edges of a path used by an Evaluator whose MaxLength is zero.
+The number of simple paths grows exponentially with their length, so the default keeps the derivation of dense networks tractable.
+*/
+const DefaultMaxLength = 6
+
+/*
+Pair identifies a derivation: the opinion Source derives about Target, which is an agent or a proposition.
+*/
+type Pair struct {
+	Source string
+	Target string
+}
+
+/*
+Result holds the derived opinion of a Pair, or the error that occurred while deriving it.
+*/
+type Result struct {
+	Pair
+	Opinion sl.Opinion
+	Err     error
+}
+
+/*
+Evaluator derives opinions from a Network. For every simple path from the source to the target,
+the opinions along the path are discounted with subjectivelogic.MultiEdgeTrustDisc or the configured Discounter, where the last edge of a path is
+a trust edge to a target agent or an opinion edge to a target proposition. The discounted opinions of the paths are then fused
+in the order of their paths, which are sorted by the names of their agents.
+Fusing paths that share an edge would count the evidence of that edge more than once, so only edge-disjoint paths are fused:
+the paths are considered from the shortest to the longest, in the order of their names among paths of the same length,
+and a path is skipped if it shares an edge with a path considered before.
+The zero value is ready to use.
+*/
+type Evaluator struct {
+	// Workers is the number of goroutines of DeriveAll. If it is not positive, runtime.GOMAXPROCS(0) is used.
+	Workers int
+	// MaxLength is the maximum number of edges of a path. If it is zero, DefaultMaxLength is used.
+	// If it is negative, the length of paths is unlimited, which is only tractable for sparse networks.
+	MaxLength int
+	// Fusion fuses the opinions of different paths. If it is nil, subjectivelogic.CumulativeFusion is used.
+	Fusion func(*sl.Opinion, *sl.Opinion) (sl.Opinion, error)
+	// Discounter discounts the opinions along a path. If it is nil, subjectivelogic.MultiEdgeTrustDisc is used.
+	Discounter sl.Discounter
+}
+
+/*
+Derive is called onto a *Network n and derives the opinion agent source holds about target with the zero Evaluator.
+*/
+func (network *Network) Derive(source, target string) (sl.Opinion, error) {
+	return Evaluator{}.Derive(context.Background(), network, source, target)
+}
+
+/*
+Pairs is called onto a *Network n and returns the pairs of all agents with all other agents and all propositions of n, sorted by name.
+*/
+func (network *Network) Pairs() []Pair {
+	agents := network.Agents()
+	targets := append(network.Propositions(), agents...)
+	sort.Strings(targets)
+	var pairs []Pair
+	for _, source := range agents {
+		for _, target := range targets {
+			if source != target {
+				pairs = append(pairs, Pair{Source: source, Target: target})
+			}
+		}
+	}
+	return pairs
+}
+
+/*
+Derive is called onto an Evaluator e and derives the opinion agent source holds about target in the *Network n.
+If there is no path from source to target, ErrNoPath is returned. If ctx is cancelled, the error of ctx is returned.
+*/
+func (e Evaluator) Derive(ctx context.Context, network *Network, source, target string) (sl.Opinion, error) {
+	return e.derive(ctx, newGraph(network), source, target)
+}
+
+/*
+DeriveAll is called onto an Evaluator e and derives the opinions of all pairs in the *Network n concurrently.
+The results are returned in the order of pairs and are independent of the scheduling of the workers.
+Errors of single pairs are reported in their Result. If ctx is cancelled, DeriveAll stops and returns the error of ctx,
+the results of pairs that were not derived hold that error as well.
+The Network must not be modified while DeriveAll runs.
+*/
+func (e Evaluator) DeriveAll(ctx context.Context, network *Network, pairs []Pair) ([]Result, error) {
+	g := newGraph(network)
+	results := make([]Result, len(pairs))
+	for i, pair := range pairs {
+		results[i] = Result{Pair: pair, Err: context.Canceled}
+	}
+
+	workers := e.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(pairs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i].Opinion, results[i].Err = e.derive(ctx, g, pairs[i].Source, pairs[i].Target)
+			}
+		}()
+	}
+
+feed:
+	for i := range pairs {
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		for i := range results {
+			if errors.Is(results[i].Err, context.Canceled) || errors.Is(results[i].Err, context.DeadlineExceeded) {
+				results[i].Err = err
+			}
+		}
+		return results, err
+	}
+	return results, nil
+}
+
+func (e Evaluator) derive(ctx context.Context, g *graph, source, target string) (sl.Opinion, error) {
+	fusion := e.Fusion
+	if fusion == nil {
+		fusion = sl.CumulativeFusion
+	}
+
+	maxLength := e.MaxLength
+	if maxLength == 0 {
+		maxLength = DefaultMaxLength
+	}
+
+	paths, err := g.independentPaths(ctx, source, target, maxLength)
+	if err != nil && ctx.Err() != nil {
+		return sl.Opinion{}, err
+	}
+	if err == nil && len(paths) == 0 {
+		err = ErrNoPath
+	}
+	var result sl.Opinion
+	for i := 0; err == nil && i < len(paths); i++ {
+		o := paths[i][0]
+		if len(paths[i]) > 1 {
+			if e.Discounter == nil {
+				o, err = sl.MultiEdgeTrustDisc(paths[i])
+			} else {
+				o, err = sl.DiscountPath(e.Discounter, paths[i])
+			}
+		}
+		if i == 0 {
+			result = o
+		} else if err == nil {
+			result, err = fusion(&result, &o)
+		}
+	}
+	if err != nil {
+		return sl.Opinion{}, fmt.Errorf("Derive: %s -> %s: %w", source, target, err)
+	}
+	return result, nil
+}
+
+/*
+graph is a read-only view of a Network that can be shared by goroutines.
+*/
+type graph struct {
+	trusted  map[string][]Edge
+	trust    map[string]map[string]sl.Opinion
+	opinions map[string]map[string]sl.Opinion
+}
+
+func newGraph(network *Network) *graph {
+	g := &graph{trusted: map[string][]Edge{}, trust: network.trust, opinions: network.opinions}
+	for _, edge := range network.TrustEdges() {
+		g.trusted[edge.From] = append(g.trusted[edge.From], edge)
+	}
+	return g
+}
+
+/*
+edge identifies an edge of a graph. Opinion edges and trust edges between the same names are different edges.
+*/
+type edge struct {
+	from, to string
+	opinion  bool
+}
+
+/*
+independentPaths returns the opinions along the edge-disjoint paths from source to target with at most maxLength edges,
+selected as described for Evaluator, in the order of the names of their agents.
+*/
+func (g *graph) independentPaths(ctx context.Context, source, target string, maxLength int) ([][]sl.Opinion, error) {
+	type candidate struct {
+		index    int
+		opinions []sl.Opinion
+		edges    []edge
+	}
+	var candidates []candidate
+	err := g.paths(ctx, source, target, maxLength, func(opinions []sl.Opinion, edges []edge) error {
+		candidates = append(candidates, candidate{len(candidates), append([]sl.Opinion(nil), opinions...), append([]edge(nil), edges...)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byLength := append([]candidate(nil), candidates...)
+	sort.SliceStable(byLength, func(i, j int) bool {
+		return len(byLength[i].edges) < len(byLength[j].edges)
+	})
+	used := map[edge]bool{}
+	selected := make([]bool, len(candidates))
+outer:
+	for _, c := range byLength {
+		for _, e := range c.edges {
+			if used[e] {
+				continue outer
+			}
+		}
+		for _, e := range c.edges {
+			used[e] = true
+		}
+		selected[c.index] = true
+	}
+
+	var paths [][]sl.Opinion
+	for _, c := range candidates {
+		if selected[c.index] {
+			paths = append(paths, c.opinions)
+		}
+	}
+	return paths, nil
+}
+
+/*
+paths calls visit with the opinions and the edges along every simple path from source to target with at most maxLength edges,
+in the order of the names of their agents. If maxLength is negative, the length of paths is unlimited.
+The slices passed to visit are only valid during the call.
+*/
+func (g *graph) paths(ctx context.Context, source, target string, maxLength int, visit func([]sl.Opinion, []edge) error) error {
+	visited := map[string]bool{source: true}
+	var path []sl.Opinion
+	var edges []edge
+
+	var walk func(agent string) error
+	walk = func(agent string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if maxLength >= 0 && len(path) >= maxLength {
+			return nil
+		}
+		if o, ok := g.trust[agent][target]; ok {
+			if err := visit(append(path, o), append(edges, edge{agent, target, false})); err != nil {
+				return err
+			}
+		}
+		if o, ok := g.opinions[agent][target]; ok {
+			if err := visit(append(path, o), append(edges, edge{agent, target, true})); err != nil {
+				return err
+			}
+		}
+		for _, trusted := range g.trusted[agent] {
+			if visited[trusted.To] || trusted.To == target {
+				continue
+			}
+			visited[trusted.To] = true
+			path = append(path, trusted.Opinion)
+			edges = append(edges, edge{agent, trusted.To, false})
+			err := walk(trusted.To)
+			path = path[:len(path)-1]
+			edges = edges[:len(edges)-1]
+			visited[trusted.To] = false
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(source)
+}
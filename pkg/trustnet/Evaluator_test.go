@@ -0,0 +1,224 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package trustnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestEvaluator_Derive(t *testing.T) {
+	var tAB, tAD, tBC, tDC, tCE, oBx, oCx sl.Opinion
+	for _, edge := range []struct {
+		opinion    *sl.Opinion
+		b, d, u, a float64
+	}{
+		{&tAB, 0.9, 0, 0.1, 0.5},
+		{&tAD, 0.6, 0.2, 0.2, 0.5},
+		{&tBC, 0.7, 0.1, 0.2, 0.5},
+		{&tDC, 0.5, 0.3, 0.2, 0.5},
+		{&tCE, 0.4, 0.4, 0.2, 0.5},
+		{&oBx, 0.2, 0.6, 0.2, 0.5},
+		{&oCx, 0.8, 0.1, 0.1, 0.3},
+	} {
+		o, err := sl.NewOpinion(edge.b, edge.d, edge.u, edge.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*edge.opinion = o
+	}
+
+	n := NewNetwork()
+	n.AddTrust("A", "B", tAB)
+	n.AddTrust("A", "D", tAD)
+	n.AddTrust("B", "C", tBC)
+	n.AddTrust("D", "C", tDC)
+	n.AddTrust("C", "E", tCE)
+	n.AddOpinion("B", "x", oBx)
+	n.AddOpinion("C", "x", oCx)
+
+	discount := func(opinions ...sl.Opinion) sl.Opinion {
+		o, err := sl.MultiEdgeTrustDisc(opinions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return o
+	}
+	fuse := func(opinions ...sl.Opinion) sl.Opinion {
+		result := opinions[0]
+		for _, o := range opinions[1:] {
+			var err error
+			if result, err = sl.CumulativeFusion(&result, &o); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return result
+	}
+
+	tests := []struct {
+		name      string
+		evaluator Evaluator
+		source    string
+		target    string
+		want      sl.Opinion
+		wantErr   error
+	}{
+		// the path A -> B -> C -> x shares the edge A -> B with the shorter path A -> B -> x and is skipped
+		{"TestEvaluator_Derive1", Evaluator{}, "A", "x", fuse(discount(tAB, oBx), discount(tAD, tDC, oCx)), nil},
+		{"TestEvaluator_Derive2", Evaluator{}, "A", "C", fuse(discount(tAB, tBC), discount(tAD, tDC)), nil},
+		{"TestEvaluator_Derive3", Evaluator{MaxLength: 2}, "A", "x", discount(tAB, oBx), nil},
+		{"TestEvaluator_Derive4", Evaluator{}, "B", "x", fuse(oBx, discount(tBC, oCx)), nil},
+		{"TestEvaluator_Derive5", Evaluator{Fusion: sl.AveragingFusion}, "A", "C", discount(tAB, tBC), nil},
+		{"TestEvaluator_Derive6", Evaluator{}, "C", "A", sl.Opinion{}, ErrNoPath},
+		{"TestEvaluator_Derive7", Evaluator{MaxLength: 1}, "A", "x", sl.Opinion{}, ErrNoPath},
+		{"TestEvaluator_Derive8", Evaluator{MaxLength: 2, Discounter: sl.UncertaintyFavouringDiscounter}, "A", "x", sl.Opinion{}, nil},
+		// the paths A -> B -> C -> E and A -> D -> C -> E share the edge C -> E, the path through D is skipped
+		{"TestEvaluator_Derive9", Evaluator{}, "A", "E", discount(tAB, tBC, tCE), nil},
+		{"TestEvaluator_Derive10", Evaluator{MaxLength: -1}, "D", "x", discount(tDC, oCx), nil},
+	}
+	// averaging fusion of the two paths of TestEvaluator_Derive5
+	o1, o2 := discount(tAB, tBC), discount(tAD, tDC)
+	tests[4].want, _ = sl.AveragingFusion(&o1, &o2)
+	// uncertainty-favouring discounting of the only path of TestEvaluator_Derive8
+	tests[7].want, _ = sl.TrustDiscountingUncertaintyFavouring(&tAB, &oBx)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.evaluator.Derive(context.Background(), n, tt.source, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Derive() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Derive() got = %v, want %v", &got, &tt.want)
+			}
+		})
+	}
+
+	if got, err := n.Derive("A", "x"); err != nil || got != tests[0].want {
+		t.Errorf("Network.Derive() got = %v, %v, want %v", &got, err, &tests[0].want)
+	}
+}
+
+func TestEvaluator_MaxLength(t *testing.T) {
+	trust, err := sl.NewOpinion(0.9, 0, 0.1, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opinion, err := sl.NewOpinion(0.8, 0.1, 0.1, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a chain of DefaultMaxLength+1 trust edges and an opinion edge
+	n := NewNetwork()
+	for i := range DefaultMaxLength + 1 {
+		n.AddTrust(fmt.Sprint("agent", i), fmt.Sprint("agent", i+1), trust)
+	}
+	n.AddOpinion(fmt.Sprint("agent", DefaultMaxLength+1), "x", opinion)
+
+	if _, err := (Evaluator{}).Derive(context.Background(), n, "agent0", "x"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("Derive() error = %v, want %v", err, ErrNoPath)
+	}
+	if _, err := (Evaluator{MaxLength: -1}).Derive(context.Background(), n, "agent0", "x"); err != nil {
+		t.Errorf("Derive() error = %v, want a path of unlimited length", err)
+	}
+	if _, err := (Evaluator{MaxLength: DefaultMaxLength + 2}).Derive(context.Background(), n, "agent0", "x"); err != nil {
+		t.Errorf("Derive() error = %v, want a path of %d edges", err, DefaultMaxLength+2)
+	}
+}
+
+func TestEvaluator_DeriveAll(t *testing.T) {
+	n := randomNetwork(t, 40, 3)
+	pairs := n.Pairs()
+
+	want, err := Evaluator{Workers: 1, MaxLength: 4}.DeriveAll(context.Background(), n, pairs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, pair := range pairs {
+		o, err := Evaluator{MaxLength: 4}.Derive(context.Background(), n, pair.Source, pair.Target)
+		if want[i].Pair != pair || want[i].Opinion != o || fmt.Sprint(want[i].Err) != fmt.Sprint(err) {
+			t.Fatalf("DeriveAll() result %d = %+v, want %v, %v", i, want[i], &o, err)
+		}
+	}
+	for _, workers := range []int{2, 8, 0} {
+		got, err := Evaluator{Workers: workers, MaxLength: 4}.DeriveAll(context.Background(), n, pairs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("DeriveAll() with %d workers differs from a single worker", workers)
+		}
+	}
+}
+
+func TestEvaluator_DeriveAll_Cancel(t *testing.T) {
+	n := randomNetwork(t, 40, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := Evaluator{}.DeriveAll(ctx, n, n.Pairs())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("DeriveAll() error = %v, want %v", err, context.Canceled)
+	}
+	for _, r := range results {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Fatalf("DeriveAll() result = %+v, want error %v", r, context.Canceled)
+		}
+	}
+}
+
+func BenchmarkEvaluator_DeriveAll(b *testing.B) {
+	n := randomNetwork(b, 200, 3)
+	pairs := n.Pairs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := (Evaluator{MaxLength: 4}).DeriveAll(context.Background(), n, pairs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+/*
+randomNetwork returns a reproducible Network of agents that each trust degree other agents and hold an opinion about one of two propositions.
+It fails tb if one of the random opinions is invalid.
+*/
+func randomNetwork(tb testing.TB, agents, degree int) *Network {
+	r := rand.New(rand.NewPCG(1, 2))
+	random := func() sl.Opinion {
+		b, d := r.Float64(), r.Float64()
+		scale := r.Float64() / (b + d)
+		o, err := sl.NewOpinion(b*scale, d*scale, 1-b*scale-d*scale, r.Float64())
+		if err != nil {
+			tb.Fatal(err)
+		}
+		return o
+	}
+	n := NewNetwork()
+	for i := range agents {
+		for range degree {
+			if j := r.IntN(agents); j != i {
+				n.AddTrust(fmt.Sprint("agent", i), fmt.Sprint("agent", j), random())
+			}
+		}
+		n.AddOpinion(fmt.Sprint("agent", i), fmt.Sprint("proposition", r.IntN(2)), random())
+	}
+	return n
+}
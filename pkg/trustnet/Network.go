@@ -0,0 +1,164 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package trustnet models trust networks of Subjective Logic: agents that hold referral trust opinions about each other
+and functional opinions about propositions.
+*/
+package trustnet
+
+import (
+	"errors"
+	"sort"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Edge is a directed edge of a Network, labelled with the opinion From holds about To.
+*/
+type Edge struct {
+	From    string
+	To      string
+	Opinion sl.Opinion
+}
+
+/*
+Network is a trust network. Trust edges connect two agents, opinion edges connect an agent to a proposition.
+The zero value is not usable, networks are created with NewNetwork.
+*/
+type Network struct {
+	trust    map[string]map[string]sl.Opinion
+	opinions map[string]map[string]sl.Opinion
+}
+
+/*
+NewNetwork returns an empty *Network.
+*/
+func NewNetwork() *Network {
+	return &Network{trust: map[string]map[string]sl.Opinion{}, opinions: map[string]map[string]sl.Opinion{}}
+}
+
+/*
+AddTrust is called onto a *Network n and sets the referral trust opinion agent from holds about agent to,
+replacing any previous one.
+*/
+func (network *Network) AddTrust(from, to string, o sl.Opinion) error {
+	if from == to {
+		return errors.New("AddTrust: Agents cannot trust themselves")
+	}
+	addEdge(network.trust, from, to, o)
+	return nil
+}
+
+/*
+AddOpinion is called onto a *Network n and sets the functional opinion agent holds about proposition,
+replacing any previous one.
+*/
+func (network *Network) AddOpinion(agent, proposition string, o sl.Opinion) {
+	addEdge(network.opinions, agent, proposition, o)
+}
+
+/*
+Trust is called onto a *Network n and returns the referral trust opinion agent from holds about agent to, if any.
+*/
+func (network *Network) Trust(from, to string) (sl.Opinion, bool) {
+	o, ok := network.trust[from][to]
+	return o, ok
+}
+
+/*
+Opinion is called onto a *Network n and returns the functional opinion agent holds about proposition, if any.
+*/
+func (network *Network) Opinion(agent, proposition string) (sl.Opinion, bool) {
+	o, ok := network.opinions[agent][proposition]
+	return o, ok
+}
+
+/*
+Trusted is called onto a *Network n and returns all agents that agent from holds a referral trust opinion about, sorted by name.
+*/
+func (network *Network) Trusted(from string) []string {
+	return sortedKeys(network.trust[from])
+}
+
+/*
+Agents is called onto a *Network n and returns the names of all agents of n, sorted by name.
+*/
+func (network *Network) Agents() []string {
+	agents := map[string]bool{}
+	for from, edges := range network.trust {
+		agents[from] = true
+		for to := range edges {
+			agents[to] = true
+		}
+	}
+	for agent := range network.opinions {
+		agents[agent] = true
+	}
+	return sortedKeys(agents)
+}
+
+/*
+Propositions is called onto a *Network n and returns the names of all propositions agents of n hold opinions about, sorted by name.
+*/
+func (network *Network) Propositions() []string {
+	propositions := map[string]bool{}
+	for _, edges := range network.opinions {
+		for proposition := range edges {
+			propositions[proposition] = true
+		}
+	}
+	return sortedKeys(propositions)
+}
+
+/*
+TrustEdges is called onto a *Network n and returns all trust edges of n, sorted by their agents.
+*/
+func (network *Network) TrustEdges() []Edge {
+	return edges(network.trust)
+}
+
+/*
+OpinionEdges is called onto a *Network n and returns all opinion edges of n, sorted by their agents and propositions.
+*/
+func (network *Network) OpinionEdges() []Edge {
+	return edges(network.opinions)
+}
+
+func addEdge(m map[string]map[string]sl.Opinion, from, to string, o sl.Opinion) {
+	if m[from] == nil {
+		m[from] = map[string]sl.Opinion{}
+	}
+	m[from][to] = o
+}
+
+func edges(m map[string]map[string]sl.Opinion) []Edge {
+	var result []Edge
+	for _, from := range sortedKeys(m) {
+		for _, to := range sortedKeys(m[from]) {
+			result = append(result, Edge{From: from, To: to, Opinion: m[from][to]})
+		}
+	}
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,80 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package trustnet
+
+import (
+	"reflect"
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestNetwork(t *testing.T) {
+	opinions := make(map[string]sl.Opinion)
+	for name, v := range map[string][4]float64{
+		"belief":   {1, 0, 0, 0.5},
+		"dogmatic": {0.5, 0.5, 0, 0.5},
+		"first":    {0.6, 0.3, 0.1, 0.5},
+		"replaced": {0.7, 0.2, 0.1, 0.5},
+		"vacuous":  {0, 0, 1, 0.5},
+	} {
+		o, err := sl.NewOpinion(v[0], v[1], v[2], v[3])
+		if err != nil {
+			t.Fatal(err)
+		}
+		opinions[name] = o
+	}
+
+	n := NewNetwork()
+	if err := n.AddTrust("A", "A", opinions["belief"]); err == nil {
+		t.Errorf("AddTrust() of a self-loop passed undetected")
+	}
+	_ = n.AddTrust("A", "C", opinions["dogmatic"])
+	_ = n.AddTrust("A", "B", opinions["first"])
+	_ = n.AddTrust("A", "B", opinions["replaced"])
+	n.AddOpinion("C", "x", opinions["vacuous"])
+	n.AddOpinion("D", "y", opinions["belief"])
+
+	if got, want := n.Agents(), []string{"A", "B", "C", "D"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Agents() got = %v, want %v", got, want)
+	}
+	if got, want := n.Propositions(), []string{"x", "y"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Propositions() got = %v, want %v", got, want)
+	}
+	if got, want := n.Trusted("A"), []string{"B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Trusted() got = %v, want %v", got, want)
+	}
+	if got := n.Trusted("B"); len(got) != 0 {
+		t.Errorf("Trusted() got = %v, want none", got)
+	}
+
+	if o, ok := n.Trust("A", "B"); !ok || !o.Compare(opinions["replaced"]) {
+		t.Errorf("Trust() got = %v, %t, want the replaced opinion", &o, ok)
+	}
+	if _, ok := n.Trust("B", "A"); ok {
+		t.Errorf("Trust() reported a missing edge")
+	}
+	if _, ok := n.Opinion("C", "x"); !ok {
+		t.Errorf("Opinion() did not report an existing edge")
+	}
+
+	edges := n.TrustEdges()
+	if len(edges) != 2 || edges[0].To != "B" || edges[1].To != "C" {
+		t.Errorf("TrustEdges() got = %v", edges)
+	}
+	if edges := n.OpinionEdges(); len(edges) != 2 || edges[0].From != "C" || edges[1].To != "y" {
+		t.Errorf("OpinionEdges() got = %v", edges)
+	}
+}
@@ -0,0 +1,194 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+/*
+Package verbal translates opinions into human language and back. A Table of categories describes an opinion by the likelihood
+of its projected probability and the certainty of its uncertainty mass.
+*/
+package verbal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+/*
+Category is a verbal label for the values up to and including Max that are greater than the Max of the preceding category.
+Value is the representative value of the category, which is used to form an opinion from the label.
+*/
+type Category struct {
+	Label string
+	Max   float64
+	Value float64
+}
+
+/*
+Table holds the categories of likelihood, i.e. of the projected probability, and of certainty, i.e. of the uncertainty mass,
+both ordered by increasing values. The label of an opinion joins its likelihood and certainty with the Separator.
+Tables for other languages are formed like English.
+*/
+type Table struct {
+	Likelihood []Category
+	Certainty  []Category
+	Separator  string
+}
+
+/*
+English is the Table of this package with nine categories of likelihood and five categories of certainty in English,
+whose combinations form 45 verbal opinions. Its boundaries and representative values were chosen by this package.
+It is not the category matrix of Jøsang's book; applications that need the ranges of the book form a Table of their own.
+*/
+var English = Table{
+	Likelihood: []Category{
+		{"absolutely not", 0.01, 0},
+		{"very unlikely", 0.1, 0.05},
+		{"unlikely", 0.3, 0.2},
+		{"somewhat unlikely", 0.45, 0.375},
+		{"chances about even", 0.55, 0.5},
+		{"somewhat likely", 0.7, 0.625},
+		{"likely", 0.9, 0.8},
+		{"very likely", 0.99, 0.95},
+		{"absolutely", 1, 1},
+	},
+	Certainty: []Category{
+		{"completely certain", 0.01, 0},
+		{"slightly uncertain", 0.25, 0.05},
+		{"uncertain", 0.5, 0.375},
+		{"very uncertain", 0.99, 0.75},
+		{"completely uncertain", 1, 1},
+	},
+	Separator: ", ",
+}
+
+/*
+Validate is called onto a Table t and returns an error if t has no categories of likelihood or certainty,
+if their Max values are not increasing up to 1, or if a Value lies outside of its category.
+*/
+func (t Table) Validate() error {
+	for name, categories := range map[string][]Category{"likelihood": t.Likelihood, "certainty": t.Certainty} {
+		if len(categories) == 0 {
+			return fmt.Errorf("Validate: no categories of %s", name)
+		}
+		lower := 0.0
+		for i, c := range categories {
+			if c.Max < lower || (i > 0 && c.Max == lower) || c.Max > 1 {
+				return fmt.Errorf("Validate: %s category %q does not increase up to 1", name, c.Label)
+			}
+			if c.Value > c.Max || c.Value < lower || (i > 0 && c.Value == lower) {
+				return fmt.Errorf("Validate: value of %s category %q lies outside of the category", name, c.Label)
+			}
+			lower = c.Max
+		}
+		if lower != 1 {
+			return fmt.Errorf("Validate: %s categories do not cover 1", name)
+		}
+	}
+	return nil
+}
+
+/*
+Describe is called onto a Table t and returns the labels of the likelihood and certainty of the Opinion o.
+*/
+func (t Table) Describe(o *sl.Opinion) (likelihood, certainty string, err error) {
+	if o == nil {
+		return "", "", errors.New("Describe: Input cannot be nil")
+	}
+	l, ok := find(t.Likelihood, o.ProjectedProbability())
+	c, ok2 := find(t.Certainty, o.Uncertainty())
+	if !ok || !ok2 {
+		return "", "", errors.New("Describe: Table does not cover the opinion")
+	}
+	return l.Label, c.Label, nil
+}
+
+/*
+Label is called onto a Table t and returns the label of the Opinion o, e.g. "very likely, slightly uncertain".
+*/
+func (t Table) Label(o *sl.Opinion) (string, error) {
+	likelihood, certainty, err := t.Describe(o)
+	if err != nil {
+		return "", err
+	}
+	return likelihood + t.Separator + certainty, nil
+}
+
+/*
+Opinion is called onto a Table t and forms the representative Opinion of the given labels of likelihood and certainty and the base rate,
+i.e. the Opinion whose projected probability and uncertainty are the representative values of the categories.
+If a label is unknown, or if the combination cannot be represented with the base rate, e.g. a completely uncertain opinion
+that is likely for a base rate of 0.5, a zeroed Opinion and an error are returned.
+*/
+func (t Table) Opinion(likelihood, certainty string, baseRate float64) (sl.Opinion, error) {
+	l, ok := lookup(t.Likelihood, likelihood)
+	if !ok {
+		return sl.Opinion{}, fmt.Errorf("Opinion: unknown likelihood %q", likelihood)
+	}
+	c, ok := lookup(t.Certainty, certainty)
+	if !ok {
+		return sl.Opinion{}, fmt.Errorf("Opinion: unknown certainty %q", certainty)
+	}
+	// P = b + a*u
+	u := c.Value
+	b := l.Value - baseRate*u
+	d := 1 - u - b
+	if b < 0 || d < 0 {
+		return sl.Opinion{}, fmt.Errorf("Opinion: %q%s%q cannot be represented with a base rate of %v", likelihood, t.Separator, certainty, baseRate)
+	}
+	o, err := sl.NewOpinion(b, d, u, baseRate)
+	if err != nil {
+		return sl.Opinion{}, fmt.Errorf("Opinion: %w", err)
+	}
+	return o, nil
+}
+
+/*
+Parse is called onto a Table t and forms the representative Opinion of a label returned by Label, like Opinion.
+*/
+func (t Table) Parse(label string, baseRate float64) (sl.Opinion, error) {
+	for _, l := range t.Likelihood {
+		if certainty, ok := strings.CutPrefix(label, l.Label+t.Separator); ok {
+			if _, ok := lookup(t.Certainty, certainty); ok {
+				return t.Opinion(l.Label, certainty, baseRate)
+			}
+		}
+	}
+	return sl.Opinion{}, fmt.Errorf("Parse: unknown label %q", label)
+}
+
+/*
+find returns the category of the value v. Values rounded slightly above 1 belong to the last category.
+*/
+func find(categories []Category, v float64) (Category, bool) {
+	for _, c := range categories {
+		if v <= c.Max {
+			return c, true
+		}
+	}
+	if len(categories) == 0 {
+		return Category{}, false
+	}
+	return categories[len(categories)-1], true
+}
+
+func lookup(categories []Category, label string) (Category, bool) {
+	for _, c := range categories {
+		if c.Label == label {
+			return c, true
+		}
+	}
+	return Category{}, false
+}
@@ -0,0 +1,194 @@
+//Copyright 2024 Institute of Distributed Systems, Ulm University
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+package verbal
+
+import (
+	"testing"
+
+	sl "github.com/vs-uulm/go-subjectivelogic/pkg/subjectivelogic"
+)
+
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		name       string
+		b, d, u, a float64
+		want       string
+	}{
+		{"TestLabel1", 0.9, 0.02, 0.08, 0.5, "very likely, slightly uncertain"},
+		{"TestLabel2", 0, 0, 1, 0.5, "chances about even, completely uncertain"},
+		{"TestLabel3", 1, 0, 0, 0.5, "absolutely, completely certain"},
+		{"TestLabel4", 0, 1, 0, 0.5, "absolutely not, completely certain"},
+		{"TestLabel5", 0.1, 0.5, 0.4, 0.3, "unlikely, uncertain"},
+		{"TestLabel6", 0.4, 0.1, 0.5, 0.5, "somewhat likely, uncertain"},
+		{"TestLabel7", 0, 0, 1, 1, "absolutely, completely uncertain"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := sl.NewOpinion(tt.b, tt.d, tt.u, tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := English.Label(&o)
+			if err != nil || got != tt.want {
+				t.Errorf("Label() got = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+	if _, err := English.Label(nil); err == nil {
+		t.Errorf("Label() of nil does not fail")
+	}
+}
+
+func TestOpinion(t *testing.T) {
+	tests := []struct {
+		name       string
+		likelihood string
+		certainty  string
+		baseRate   float64
+		want       [3]float64
+		wantErr    bool
+	}{
+		{"TestOpinion1", "very likely", "slightly uncertain", 0.5, [3]float64{0.925, 0.025, 0.05}, false},
+		{"TestOpinion2", "chances about even", "completely uncertain", 0.5, [3]float64{0, 0, 1}, false},
+		{"TestOpinion3", "absolutely", "completely certain", 0.2, [3]float64{1, 0, 0}, false},
+		{"TestOpinion4", "unlikely", "very uncertain", 0.2, [3]float64{0.05, 0.2, 0.75}, false},
+		// a completely uncertain opinion is as likely as its base rate
+		{"TestOpinion5", "likely", "completely uncertain", 0.5, [3]float64{}, true},
+		{"TestOpinion6", "absolutely", "uncertain", 0.5, [3]float64{}, true},
+		{"TestOpinion7", "very likely", "uncertain", 0.5, [3]float64{}, true},
+		{"TestOpinion8", "probable", "uncertain", 0.5, [3]float64{}, true},
+		{"TestOpinion9", "likely", "sure", 0.5, [3]float64{}, true},
+	}
+	e := sl.Evaluator{Tolerance: 1e-9}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := English.Opinion(tt.likelihood, tt.certainty, tt.baseRate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Opinion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			want, err := sl.NewOpinion(tt.want[0], tt.want[1], tt.want[2], tt.baseRate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !e.Compare(got, want) {
+				t.Errorf("Opinion() got = %v, want %v", &got, &want)
+			}
+			// the representative opinion is described by its labels
+			if l, c, _ := English.Describe(&got); l != tt.likelihood || c != tt.certainty {
+				t.Errorf("Describe() of the representative opinion got = %q, %q", l, c)
+			}
+		})
+	}
+}
+
+func TestEnglish(t *testing.T) {
+	// a value on the boundary Max belongs to its category, a value above it to the next one
+	for i, c := range English.Likelihood {
+		for _, tt := range []struct {
+			p    float64
+			want string
+		}{{c.Max, c.Label}, {c.Max + 1e-9, English.Likelihood[min(i+1, len(English.Likelihood)-1)].Label}} {
+			if tt.p > 1 {
+				continue
+			}
+			o, err := sl.NewOpinion(tt.p, 1-tt.p, 0, 0.5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, _, err := English.Describe(&o); err != nil || got != tt.want {
+				t.Errorf("Describe() of the projected probability %v got = %q, %v, want %q", tt.p, got, err, tt.want)
+			}
+		}
+	}
+	for i, c := range English.Certainty {
+		for _, tt := range []struct {
+			u    float64
+			want string
+		}{{c.Max, c.Label}, {c.Max + 1e-9, English.Certainty[min(i+1, len(English.Certainty)-1)].Label}} {
+			if tt.u > 1 {
+				continue
+			}
+			o, err := sl.NewOpinion(0, 1-tt.u, tt.u, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, got, err := English.Describe(&o); err != nil || got != tt.want {
+				t.Errorf("Describe() of the uncertainty %v got = %q, %v, want %q", tt.u, got, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	o, err := sl.NewOpinion(0.7, 0.1, 0.2, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	label, _ := English.Label(&o)
+	got, err := English.Parse(label, 0.5)
+	want, _ := English.Opinion("likely", "slightly uncertain", 0.5)
+	if label != "likely, slightly uncertain" || err != nil || got != want {
+		t.Errorf("Parse(%q) got = %v, %v, want %v", label, &got, err, &want)
+	}
+	for _, label := range []string{"likely", "likely, ", "likely; uncertain", "uncertain, likely"} {
+		if _, err := English.Parse(label, 0.5); err == nil {
+			t.Errorf("Parse(%q) does not fail", label)
+		}
+	}
+}
+
+func TestTable(t *testing.T) {
+	german := Table{
+		Likelihood: []Category{{"unwahrscheinlich", 0.4, 0.2}, {"offen", 0.6, 0.5}, {"wahrscheinlich", 1, 0.8}},
+		Certainty:  []Category{{"sicher", 0.2, 0}, {"unsicher", 1, 0.6}},
+		Separator:  " und ",
+	}
+	if err := English.Validate(); err != nil {
+		t.Errorf("Validate() of English error = %v", err)
+	}
+	if err := german.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	o, err := sl.NewOpinion(0.7, 0.2, 0.1, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := german.Label(&o); err != nil || got != "wahrscheinlich und sicher" {
+		t.Errorf("Label() got = %q, %v", got, err)
+	}
+	want, err := sl.NewOpinion(0.2, 0.2, 0.6, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := german.Parse("offen und unsicher", 0.5); err != nil || !(sl.Evaluator{Tolerance: 1e-9}).Compare(got, want) {
+		t.Errorf("Parse() got = %v, %v, want %v", &got, err, &want)
+	}
+
+	invalid := []Table{
+		{},
+		{Likelihood: []Category{{"low", 0.5, 0.2}}, Certainty: german.Certainty},
+		{Likelihood: []Category{{"high", 0.6, 0.5}, {"low", 0.4, 0.2}, {"top", 1, 1}}, Certainty: german.Certainty},
+		{Likelihood: []Category{{"low", 0.5, 0.7}, {"high", 1, 1}}, Certainty: german.Certainty},
+	}
+	for i, table := range invalid {
+		if err := table.Validate(); err == nil {
+			t.Errorf("Validate() of invalid table %d does not fail", i)
+		}
+	}
+}